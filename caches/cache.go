@@ -16,8 +16,9 @@ const (
 )
 
 var (
-	ErrNotFound   = errors.New("cache value was not found for specified key")
-	ErrNotPointer = errors.New("interface value must be a pointer")
+	ErrNotFound         = errors.New("cache value was not found for specified key")
+	ErrNotPointer       = errors.New("interface value must be a pointer")
+	ErrLoadTypeMismatch = errors.New("loaded value type does not match requested type")
 )
 
 // Cache is an interface defining cache contract with individual ttl configuration on set.
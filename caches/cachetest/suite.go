@@ -0,0 +1,224 @@
+// Package cachetest provides a conformance test suite verifying that caches.Cache and caches.DistributedCache
+// implementations follow the contract documented in caches package.
+package cachetest
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/caches"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+const (
+	// TTL is ttl used to verify expiration. It's long enough for backends with millisecond precision.
+	TTL = 50 * time.Millisecond
+)
+
+// Value is a value stored in cache during tests. It has exported fields with tags, so it can be
+// encoded by serializing backends.
+type Value struct {
+	Name  string `json:"name" xml:"name"`
+	Count int    `json:"count" xml:"count"`
+}
+
+// TestCache runs the full contract against caches.Cache returned by newCache. Every test case
+// calls newCache to receive an empty cache instance.
+func TestCache(t *testing.T, newCache func() caches.Cache) {
+	TestDistributedCache(t, func() caches.DistributedCache {
+		return contextCache{cache: newCache()}
+	})
+}
+
+// TestDistributedCache runs the full contract against caches.DistributedCache returned by newCache. Every test case
+// calls newCache to receive an empty cache instance.
+func TestDistributedCache(t *testing.T, newCache func() caches.DistributedCache) {
+	t.Run("set-get", func(t *testing.T) {
+		testSetGet(t, newCache())
+	})
+	t.Run("set-replace", func(t *testing.T) {
+		testSetReplace(t, newCache())
+	})
+	t.Run("get-not-found", func(t *testing.T) {
+		testGetNotFound(t, newCache())
+	})
+	t.Run("get-not-pointer", func(t *testing.T) {
+		testGetNotPointer(t, newCache())
+	})
+	t.Run("delete", func(t *testing.T) {
+		testDelete(t, newCache())
+	})
+	t.Run("delete-not-found", func(t *testing.T) {
+		testDeleteNotFound(t, newCache())
+	})
+	t.Run("no-expiration", func(t *testing.T) {
+		testNoExpiration(t, newCache())
+	})
+	t.Run("ttl-expiration", func(t *testing.T) {
+		testExpiration(t, newCache())
+	})
+	t.Run("concurrent-access", func(t *testing.T) {
+		testConcurrentAccess(t, newCache())
+	})
+}
+
+func testSetGet(t *testing.T, cache caches.DistributedCache) {
+	ctx := context.Background()
+	expected := Value{Name: "foo", Count: 1}
+
+	err := cache.Set(ctx, "key", expected, caches.NoExpiration)
+	assert.NilError(t, err, "Set")
+
+	var actual Value
+	err = cache.Get(ctx, "key", &actual)
+	assert.NilError(t, err, "Get")
+	assert.Equal(t, expected, actual, "Get")
+}
+
+func testSetReplace(t *testing.T, cache caches.DistributedCache) {
+	ctx := context.Background()
+	expected := Value{Name: "bar", Count: 2}
+
+	err := cache.Set(ctx, "key", Value{Name: "foo", Count: 1}, caches.NoExpiration)
+	assert.NilError(t, err, "first Set")
+
+	err = cache.Set(ctx, "key", expected, caches.NoExpiration)
+	assert.NilError(t, err, "second Set")
+
+	var actual Value
+	err = cache.Get(ctx, "key", &actual)
+	assert.NilError(t, err, "Get")
+	assert.Equal(t, expected, actual, "Get")
+}
+
+func testGetNotFound(t *testing.T, cache caches.DistributedCache) {
+	var actual Value
+	err := cache.Get(context.Background(), "missing", &actual)
+	assert.ErrorIs(t, err, caches.ErrNotFound, "Get")
+}
+
+func testGetNotPointer(t *testing.T, cache caches.DistributedCache) {
+	ctx := context.Background()
+
+	err := cache.Set(ctx, "key", Value{Name: "foo"}, caches.NoExpiration)
+	assert.NilError(t, err, "Set")
+
+	err = cache.Get(ctx, "key", nil)
+	assert.ErrorIs(t, err, caches.ErrNotPointer, "Get nil")
+
+	err = cache.Get(ctx, "key", Value{})
+	assert.ErrorIs(t, err, caches.ErrNotPointer, "Get non-pointer")
+
+	var nilPointer *Value
+	err = cache.Get(ctx, "key", nilPointer)
+	assert.ErrorIs(t, err, caches.ErrNotPointer, "Get nil pointer")
+}
+
+func testDelete(t *testing.T, cache caches.DistributedCache) {
+	ctx := context.Background()
+
+	err := cache.Set(ctx, "key", Value{Name: "foo"}, caches.NoExpiration)
+	assert.NilError(t, err, "Set")
+
+	err = cache.Delete(ctx, "key")
+	assert.NilError(t, err, "Delete")
+
+	var actual Value
+	err = cache.Get(ctx, "key", &actual)
+	assert.ErrorIs(t, err, caches.ErrNotFound, "Get after Delete")
+}
+
+func testDeleteNotFound(t *testing.T, cache caches.DistributedCache) {
+	err := cache.Delete(context.Background(), "missing")
+	assert.ErrorIs(t, err, caches.ErrNotFound, "Delete")
+}
+
+func testNoExpiration(t *testing.T, cache caches.DistributedCache) {
+	ctx := context.Background()
+
+	err := cache.Set(ctx, "key", Value{Name: "foo"}, caches.NoExpiration)
+	assert.NilError(t, err, "Set")
+
+	time.Sleep(2 * TTL)
+
+	var actual Value
+	err = cache.Get(ctx, "key", &actual)
+	assert.NilError(t, err, "Get")
+}
+
+func testExpiration(t *testing.T, cache caches.DistributedCache) {
+	ctx := context.Background()
+
+	err := cache.Set(ctx, "key", Value{Name: "foo"}, TTL)
+	assert.NilError(t, err, "Set")
+
+	var actual Value
+	err = cache.Get(ctx, "key", &actual)
+	assert.NilError(t, err, "Get before expiration")
+
+	time.Sleep(2 * TTL)
+
+	err = cache.Get(ctx, "key", &actual)
+	assert.ErrorIs(t, err, caches.ErrNotFound, "Get after expiration")
+}
+
+func testConcurrentAccess(t *testing.T, cache caches.DistributedCache) {
+	const (
+		workers = 8
+		keys    = 50
+	)
+
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < keys; j++ {
+				key := strconv.Itoa(j)
+				if err := cache.Set(ctx, key, Value{Name: key, Count: i}, caches.NoExpiration); err != nil {
+					t.Errorf("Set: unexpected error: %v", err)
+					return
+				}
+
+				var actual Value
+				err := cache.Get(ctx, key, &actual)
+				if err != nil {
+					t.Errorf("Get: unexpected error: %v", err)
+					return
+				}
+				if actual.Name != key {
+					t.Errorf("Get: expected: '%v', actual: '%v'", key, actual.Name)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for j := 0; j < keys; j++ {
+		err := cache.Delete(ctx, strconv.Itoa(j))
+		assert.NilError(t, err, "Delete")
+	}
+}
+
+// contextCache adapts caches.Cache to caches.DistributedCache ignoring ctx.
+type contextCache struct {
+	cache caches.Cache
+}
+
+func (c contextCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	return c.cache.Set(key, value, ttl)
+}
+
+func (c contextCache) Get(ctx context.Context, key string, v interface{}) error {
+	return c.cache.Get(key, v)
+}
+
+func (c contextCache) Delete(ctx context.Context, key string) error {
+	return c.cache.Delete(key)
+}
@@ -0,0 +1,406 @@
+package caches
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/exception"
+)
+
+var (
+	_ DistributedCache = contextCache{}
+)
+
+var (
+	defaultLoadGroup = NewLoadGroup()
+)
+
+// LoaderFunc is a function which loads value for specified key from the origin source.
+// To make use of negative caching it should return error matching ErrNotFound if there is no value for the key.
+type LoaderFunc[T any] func(ctx context.Context, key string) (T, error)
+
+// LoadEntry is an envelope stored in cache by GetOrLoad when stale-while-revalidate or negative caching is enabled.
+// StaleAt is zero if value never becomes stale. NotFound marks cached "not found" result of loader.
+type LoadEntry[T any] struct {
+	Value    T         `json:"value" xml:"value"`
+	StaleAt  time.Time `json:"staleAt" xml:"staleAt"`
+	NotFound bool      `json:"notFound" xml:"notFound"`
+}
+
+// LoadOptions stores settings to control behaviour of GetOrLoad.
+type LoadOptions struct {
+	Group        *LoadGroup
+	ErrorTTL     time.Duration
+	SoftTTL      time.Duration
+	NegativeTTL  time.Duration
+	ErrorHandler func(err error)
+}
+
+// LoadOption is function which mutates the LoadOptions specific field.
+type LoadOption func(*LoadOptions)
+
+// NewLoadOptions returns a new LoadOptions. If no LoadGroup was configured the default shared one is used.
+func NewLoadOptions(opts ...LoadOption) *LoadOptions {
+	o := &LoadOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.Group == nil {
+		o.Group = defaultLoadGroup
+	}
+
+	return o
+}
+
+// WithLoadGroup returns a new LoadOption to configure LoadGroup which coalesces concurrent loads.
+// Loads are coalesced per cache and requested value type, so the group can be shared by different caches.
+func WithLoadGroup(g *LoadGroup) LoadOption {
+	return func(o *LoadOptions) {
+		o.Group = g
+	}
+}
+
+// WithErrorTTL returns a new LoadOption to enable caching loader errors for ttl duration.
+// Cached error is stored in LoadGroup and returned for the key of the same cache and value type
+// without calling loader until it expires.
+// Errors are not cached by default.
+func WithErrorTTL(ttl time.Duration) LoadOption {
+	return func(o *LoadOptions) {
+		o.ErrorTTL = ttl
+	}
+}
+
+// WithSoftTTL returns a new LoadOption to enable stale-while-revalidate mode. After soft ttl passes
+// the stale value is still returned immediately while a single background refresh runs. The ttl passed
+// to GetOrLoad is the hard ttl after which the value is removed from cache.
+// Values are stored wrapped in LoadEntry, so the same cache keys should always be loaded with this mode.
+func WithSoftTTL(ttl time.Duration) LoadOption {
+	return func(o *LoadOptions) {
+		o.SoftTTL = ttl
+	}
+}
+
+// WithNegativeTTL returns a new LoadOption to enable caching "not found" results. If loader returns error
+// matching ErrNotFound, it's stored in cache for ttl and ErrNotFound is returned without calling loader until it expires.
+// Values are stored wrapped in LoadEntry, so the same cache keys should always be loaded with this mode.
+func WithNegativeTTL(ttl time.Duration) LoadOption {
+	return func(o *LoadOptions) {
+		o.NegativeTTL = ttl
+	}
+}
+
+// WithErrorHandler returns a new LoadOption to configure handler for errors occurred in background refresh.
+func WithErrorHandler(errorHandler func(err error)) LoadOption {
+	return func(o *LoadOptions) {
+		o.ErrorHandler = errorHandler
+	}
+}
+
+// wrapsEntry reports whether values should be stored wrapped in LoadEntry.
+func (o *LoadOptions) wrapsEntry() bool {
+	return o.SoftTTL > 0 || o.NegativeTTL > 0
+}
+
+type loadCall struct {
+	done chan struct{}
+	val  any
+	err  error
+}
+
+type loadError struct {
+	err       error
+	expiresAt time.Time
+}
+
+// LoadGroup coalesces concurrent loads for the same key so only one loader runs at a time
+// while other callers wait for its result. It also stores loader errors if error caching is enabled.
+type LoadGroup struct {
+	mu     sync.Mutex
+	calls  map[string]*loadCall
+	errors map[string]loadError
+}
+
+// NewLoadGroup returns a new LoadGroup.
+func NewLoadGroup() *LoadGroup {
+	return &LoadGroup{
+		calls:  make(map[string]*loadCall),
+		errors: make(map[string]loadError),
+	}
+}
+
+// do executes fn for the key in new goroutine if there is no pending execution for it and waits for
+// the execution result or until ctx is done. fn is called with context carrying ctx values but not its
+// cancellation, so caller which gives up does not cancel the load for other waiting callers.
+// Panic raised by fn is returned as error.
+func (g *LoadGroup) do(ctx context.Context, key string, fn func(ctx context.Context) (any, error)) (any, error) {
+	c, started := g.begin(key)
+	if started {
+		loadCtx := detachedContext{parent: ctx}
+		go g.run(key, c, func() (any, error) {
+			return fn(loadCtx)
+		})
+	}
+
+	select {
+	case <-c.done:
+		return c.val, c.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// doAsync executes fn for the key in new goroutine if there is no pending execution for it.
+// Error returned by fn is passed to onError if it's not nil.
+func (g *LoadGroup) doAsync(key string, fn func() (any, error), onError func(err error)) {
+	c, started := g.begin(key)
+	if !started {
+		return
+	}
+
+	go func() {
+		g.run(key, c, fn)
+		if c.err != nil && onError != nil {
+			onError(c.err)
+		}
+	}()
+}
+
+// begin returns pending call for the key and false or registers a new one and returns true.
+func (g *LoadGroup) begin(key string) (*loadCall, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if c, ok := g.calls[key]; ok {
+		return c, false
+	}
+
+	c := &loadCall{done: make(chan struct{})}
+	g.calls[key] = c
+	return c, true
+}
+
+// run executes fn storing its result in c and releases waiters afterwards.
+func (g *LoadGroup) run(key string, c *loadCall, fn func() (any, error)) {
+	func() {
+		defer exception.HandlePanicError(func(err error) {
+			c.err = err
+		})
+		c.val, c.err = fn()
+	}()
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+	close(c.done)
+}
+
+// cachedError returns the cached loader error for the key if it has not expired yet.
+func (g *LoadGroup) cachedError(key string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	e, ok := g.errors[key]
+	if !ok {
+		return nil
+	}
+
+	if !time.Now().Before(e.expiresAt) {
+		delete(g.errors, key)
+		return nil
+	}
+
+	return e.err
+}
+
+func (g *LoadGroup) cacheError(key string, err error, ttl time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.errors[key] = loadError{err: err, expiresAt: time.Now().Add(ttl)}
+}
+
+// GetOrLoad returns value stored in cache with key. If there is no value, loader is called and its result
+// is stored in cache with ttl. Concurrent calls for the same key are coalesced so only one loader runs at a time
+// and other callers wait for its result. The loader and cache Set are called with context carrying ctx values but not
+// its cancellation, so each caller gives up only when its own ctx is done while the load continues for the others.
+// Errors other than ErrNotFound returned by cache Get are returned immediately. If loaded value cannot be stored, the value is returned together with the Set error.
+func GetOrLoad[T any](ctx context.Context, cache Cache, key string, ttl time.Duration, loader LoaderFunc[T], opts ...LoadOption) (T, error) {
+	return GetOrLoadDistributed(ctx, contextCache{cache: cache}, key, ttl, loader, opts...)
+}
+
+// GetOrLoadDistributed works as GetOrLoad for DistributedCache passing ctx values to every cache call.
+// Background refresh in stale-while-revalidate mode is not bound to ctx and uses context.Background().
+func GetOrLoadDistributed[T any](ctx context.Context, cache DistributedCache, key string, ttl time.Duration, loader LoaderFunc[T], opts ...LoadOption) (T, error) {
+	options := NewLoadOptions(opts...)
+	if options.wrapsEntry() {
+		return getOrLoadEntry(ctx, cache, key, ttl, loader, options)
+	}
+
+	var v T
+	err := cache.Get(ctx, key, &v)
+	if err == nil {
+		return v, nil
+	}
+
+	var zero T
+	if !errors.Is(err, ErrNotFound) {
+		return zero, err
+	}
+
+	groupKey := loadKey[T](cache, key)
+	val, err := options.Group.do(ctx, groupKey, func(ctx context.Context) (any, error) {
+		loaded, err := load(ctx, key, groupKey, loader, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return loaded, cache.Set(ctx, key, loaded, ttl)
+	})
+
+	return loadedValue[T](val, err)
+}
+
+// getOrLoadEntry works as GetOrLoadDistributed storing values wrapped in LoadEntry.
+func getOrLoadEntry[T any](ctx context.Context, cache DistributedCache, key string, ttl time.Duration, loader LoaderFunc[T], options *LoadOptions) (T, error) {
+	var zero T
+
+	var e LoadEntry[T]
+	err := cache.Get(ctx, key, &e)
+	if err == nil {
+		if e.NotFound {
+			return zero, ErrNotFound
+		}
+
+		if !e.StaleAt.IsZero() && !time.Now().Before(e.StaleAt) {
+			options.Group.doAsync(loadKey[T](cache, key), func() (any, error) {
+				return loadEntry(context.Background(), cache, key, ttl, loader, options)
+			}, options.ErrorHandler)
+		}
+
+		return e.Value, nil
+	}
+
+	if !errors.Is(err, ErrNotFound) {
+		return zero, err
+	}
+
+	val, err := options.Group.do(ctx, loadKey[T](cache, key), func(ctx context.Context) (any, error) {
+		return loadEntry(ctx, cache, key, ttl, loader, options)
+	})
+
+	return loadedValue[T](val, err)
+}
+
+// loadedValue returns val result of LoadGroup call as T. If there is no error and val is not T,
+// ErrLoadTypeMismatch is returned.
+func loadedValue[T any](val any, err error) (T, error) {
+	loaded, ok := val.(T)
+	if !ok && err == nil {
+		return loaded, fmt.Errorf("%w: got %T, want %v", ErrLoadTypeMismatch, val, reflect.TypeOf((*T)(nil)).Elem())
+	}
+
+	return loaded, err
+}
+
+// loadKey returns key of the load in LoadGroup. The key is scoped by cache and requested value type,
+// so loads and cached errors of different caches sharing the same LoadGroup do not collide.
+func loadKey[T any](cache DistributedCache, key string) string {
+	return fmt.Sprintf("%v|%v|%v", cacheScope(cache), reflect.TypeOf((*T)(nil)).Elem(), key)
+}
+
+// cacheScope returns identity of cache. Reference values are identified by their address,
+// other values only by their type.
+func cacheScope(cache DistributedCache) string {
+	var v any = cache
+	if c, ok := cache.(contextCache); ok {
+		v = c.cache
+	}
+
+	val := reflect.ValueOf(v)
+	switch val.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return fmt.Sprintf("%T@%x", v, val.Pointer())
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// loadEntry calls loader and stores its result wrapped in LoadEntry. If loader returns ErrNotFound and negative
+// caching is enabled, the "not found" entry is stored with negative ttl.
+func loadEntry[T any](ctx context.Context, cache DistributedCache, key string, ttl time.Duration, loader LoaderFunc[T], options *LoadOptions) (any, error) {
+	loaded, err := load(ctx, key, loadKey[T](cache, key), loader, options)
+	if err != nil {
+		if options.NegativeTTL > 0 && errors.Is(err, ErrNotFound) {
+			if setErr := cache.Set(ctx, key, LoadEntry[T]{NotFound: true}, options.NegativeTTL); setErr != nil {
+				return nil, errors.Join(err, setErr)
+			}
+		}
+		return nil, err
+	}
+
+	e := LoadEntry[T]{Value: loaded}
+	if options.SoftTTL > 0 {
+		e.StaleAt = time.Now().Add(options.SoftTTL)
+	}
+
+	return loaded, cache.Set(ctx, key, e, ttl)
+}
+
+// load returns cached loader error if there is one, otherwise it calls loader and caches its error if enabled.
+// Errors are cached in LoadGroup under groupKey.
+func load[T any](ctx context.Context, key, groupKey string, loader LoaderFunc[T], options *LoadOptions) (T, error) {
+	if err := options.Group.cachedError(groupKey); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	loaded, err := loader(ctx, key)
+	if err != nil && options.ErrorTTL > 0 {
+		options.Group.cacheError(groupKey, err, options.ErrorTTL)
+	}
+
+	return loaded, err
+}
+
+// detachedContext carries values of parent context without its deadline and cancellation.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (c detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+
+func (c detachedContext) Done() <-chan struct{} { return nil }
+
+func (c detachedContext) Err() error { return nil }
+
+func (c detachedContext) Value(key any) any { return c.parent.Value(key) }
+
+// contextCache adapts Cache to DistributedCache. It checks ctx cancellation before every call.
+type contextCache struct {
+	cache Cache
+}
+
+func (c contextCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return c.cache.Set(key, value, ttl)
+}
+
+func (c contextCache) Get(ctx context.Context, key string, v interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return c.cache.Get(key, v)
+}
+
+func (c contextCache) Delete(ctx context.Context, key string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return c.cache.Delete(key)
+}
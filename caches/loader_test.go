@@ -0,0 +1,417 @@
+package caches_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/caches"
+	"github.com/Prastiwar/Go-flow/caches/memory"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+	"github.com/Prastiwar/Go-flow/tests/mocks"
+)
+
+func TestGetOrLoad(t *testing.T) {
+	t.Run("success-cached", func(t *testing.T) {
+		cache := memory.NewCache(context.Background(), 0)
+		assert.NilError(t, cache.Set("key", "cached", caches.NoExpiration))
+
+		actual, err := caches.GetOrLoad(context.Background(), cache, "key", caches.NoExpiration,
+			func(ctx context.Context, key string) (string, error) {
+				t.Fatal("loader should not be called")
+				return "", nil
+			},
+		)
+
+		assert.NilError(t, err)
+		assert.Equal(t, "cached", actual)
+	})
+
+	t.Run("success-loaded-and-stored", func(t *testing.T) {
+		cache := memory.NewCache(context.Background(), 0)
+		loaderCounter := assert.Count(t, 1)
+
+		actual, err := caches.GetOrLoad(context.Background(), cache, "key", caches.NoExpiration,
+			func(ctx context.Context, key string) (string, error) {
+				loaderCounter.Inc()
+				assert.Equal(t, "key", key)
+				return "loaded", nil
+			},
+		)
+
+		assert.NilError(t, err)
+		assert.Equal(t, "loaded", actual)
+
+		var stored string
+		assert.NilError(t, cache.Get("key", &stored))
+		assert.Equal(t, "loaded", stored)
+	})
+
+	t.Run("invalid-cache-error", func(t *testing.T) {
+		cacheErr := errors.New("connection refused")
+		cache := mocks.CacheMock{
+			OnGet: func(key string, v interface{}) error {
+				return cacheErr
+			},
+		}
+
+		_, err := caches.GetOrLoad(context.Background(), cache, "key", caches.NoExpiration,
+			func(ctx context.Context, key string) (string, error) {
+				t.Fatal("loader should not be called")
+				return "", nil
+			},
+		)
+
+		assert.ErrorIs(t, err, cacheErr)
+	})
+
+	t.Run("invalid-set-error", func(t *testing.T) {
+		setErr := errors.New("set failure")
+		cache := mocks.CacheMock{
+			OnGet: func(key string, v interface{}) error {
+				return caches.ErrNotFound
+			},
+			OnSet: func(key string, value interface{}, ttl time.Duration) error {
+				return setErr
+			},
+		}
+
+		actual, err := caches.GetOrLoad(context.Background(), cache, "key", caches.NoExpiration,
+			func(ctx context.Context, key string) (string, error) {
+				return "loaded", nil
+			},
+		)
+
+		assert.ErrorIs(t, err, setErr)
+		assert.Equal(t, "loaded", actual)
+	})
+
+	t.Run("invalid-loader-panic", func(t *testing.T) {
+		cache := memory.NewCache(context.Background(), 0)
+
+		_, err := caches.GetOrLoad(context.Background(), cache, "key", caches.NoExpiration,
+			func(ctx context.Context, key string) (string, error) {
+				panic("loader panic")
+			},
+			caches.WithLoadGroup(caches.NewLoadGroup()),
+		)
+
+		assert.ErrorWith(t, err, "loader panic")
+	})
+}
+
+func TestGetOrLoadErrorCaching(t *testing.T) {
+	loaderErr := errors.New("loader failure")
+
+	t.Run("disabled", func(t *testing.T) {
+		cache := memory.NewCache(context.Background(), 0)
+		group := caches.NewLoadGroup()
+		loaderCounter := assert.Count(t, 2)
+		loader := func(ctx context.Context, key string) (int, error) {
+			loaderCounter.Inc()
+			return 0, loaderErr
+		}
+
+		for i := 0; i < 2; i++ {
+			_, err := caches.GetOrLoad(context.Background(), cache, "key", caches.NoExpiration, loader, caches.WithLoadGroup(group))
+			assert.ErrorIs(t, err, loaderErr)
+		}
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		cache := memory.NewCache(context.Background(), 0)
+		group := caches.NewLoadGroup()
+		loaderCounter := assert.Count(t, 2)
+		loader := func(ctx context.Context, key string) (int, error) {
+			loaderCounter.Inc()
+			return 0, loaderErr
+		}
+		opts := []caches.LoadOption{caches.WithLoadGroup(group), caches.WithErrorTTL(20 * time.Millisecond)}
+
+		for i := 0; i < 3; i++ {
+			_, err := caches.GetOrLoad(context.Background(), cache, "key", caches.NoExpiration, loader, opts...)
+			assert.ErrorIs(t, err, loaderErr)
+		}
+
+		time.Sleep(30 * time.Millisecond)
+
+		_, err := caches.GetOrLoad(context.Background(), cache, "key", caches.NoExpiration, loader, opts...)
+		assert.ErrorIs(t, err, loaderErr, "after error expiration")
+	})
+}
+
+func TestGetOrLoadCoalescing(t *testing.T) {
+	const callers = 10
+
+	cache := memory.NewCache(context.Background(), 0)
+	group := caches.NewLoadGroup()
+	release := make(chan struct{})
+	var calls int32
+
+	loader := func(ctx context.Context, key string) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return 42, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]int, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v, err := caches.GetOrLoad(context.Background(), cache, "key", caches.NoExpiration, loader, caches.WithLoadGroup(group))
+			assert.NilError(t, err)
+			results[i] = v
+		}(i)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	for _, v := range results {
+		assert.Equal(t, 42, v)
+	}
+}
+
+func TestGetOrLoadSharedGroup(t *testing.T) {
+	users := memory.NewCache(context.Background(), 0)
+	counts := memory.NewCache(context.Background(), 0)
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		v, err := caches.GetOrLoad(context.Background(), users, "42", caches.NoExpiration,
+			func(ctx context.Context, key string) (string, error) {
+				close(started)
+				<-release
+				return "user", nil
+			},
+		)
+		assert.NilError(t, err)
+		assert.Equal(t, "user", v)
+	}()
+	<-started
+
+	v, err := caches.GetOrLoad(context.Background(), counts, "42", caches.NoExpiration,
+		func(ctx context.Context, key string) (int, error) {
+			return 7, nil
+		},
+	)
+	close(release)
+	wg.Wait()
+
+	assert.NilError(t, err)
+	assert.Equal(t, 7, v)
+
+	var stored int
+	assert.NilError(t, counts.Get("42", &stored))
+	assert.Equal(t, 7, stored)
+
+	loaderErr := errors.New("loader failure")
+	_, err = caches.GetOrLoad(context.Background(), users, "err", caches.NoExpiration,
+		func(ctx context.Context, key string) (string, error) {
+			return "", loaderErr
+		},
+		caches.WithErrorTTL(time.Minute),
+	)
+	assert.ErrorIs(t, err, loaderErr)
+
+	actual, err := caches.GetOrLoad(context.Background(), counts, "err", caches.NoExpiration,
+		func(ctx context.Context, key string) (int, error) {
+			return 1, nil
+		},
+		caches.WithErrorTTL(time.Minute),
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, actual)
+}
+
+func TestGetOrLoadDistributedCancellation(t *testing.T) {
+	cache := mocks.DistributedCacheMock{
+		OnGet: func(ctx context.Context, key string, v interface{}) error {
+			return caches.ErrNotFound
+		},
+		OnSet: func(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+			return nil
+		},
+	}
+	group := caches.NewLoadGroup()
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	go func() {
+		_, _ = caches.GetOrLoadDistributed(context.Background(), cache, "key", caches.NoExpiration,
+			func(ctx context.Context, key string) (int, error) {
+				close(started)
+				<-release
+				return 1, nil
+			},
+			caches.WithLoadGroup(group),
+		)
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := caches.GetOrLoadDistributed(ctx, cache, "key", caches.NoExpiration,
+		func(ctx context.Context, key string) (int, error) {
+			t.Fatal("loader should not be called")
+			return 0, nil
+		},
+		caches.WithLoadGroup(group),
+	)
+	close(release)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestGetOrLoadLeaderCancellation(t *testing.T) {
+	cache := memory.NewCache(context.Background(), 0)
+	group := caches.NewLoadGroup()
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := caches.GetOrLoad(leaderCtx, cache, "key", caches.NoExpiration,
+			func(ctx context.Context, key string) (int, error) {
+				close(started)
+				<-release
+				assert.NilError(t, ctx.Err(), "load context should not be canceled")
+				return 42, nil
+			},
+			caches.WithLoadGroup(group),
+		)
+		leaderErr <- err
+	}()
+	<-started
+
+	waiterResult := make(chan int, 1)
+	go func() {
+		v, err := caches.GetOrLoad(context.Background(), cache, "key", caches.NoExpiration,
+			func(ctx context.Context, key string) (int, error) {
+				t.Error("loader should not be called")
+				return 0, nil
+			},
+			caches.WithLoadGroup(group),
+		)
+		assert.NilError(t, err)
+		waiterResult <- v
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+
+	close(release)
+	assert.Equal(t, 42, <-waiterResult)
+
+	var stored int
+	assert.NilError(t, cache.Get("key", &stored))
+	assert.Equal(t, 42, stored)
+}
+
+func TestGetOrLoadStaleWhileRevalidate(t *testing.T) {
+	cache := memory.NewCache(context.Background(), 0)
+	group := caches.NewLoadGroup()
+	var calls int32
+
+	loader := func(ctx context.Context, key string) (int32, error) {
+		n := atomic.AddInt32(&calls, 1)
+		if n > 1 {
+			time.Sleep(10 * time.Millisecond)
+		}
+		return n, nil
+	}
+	opts := []caches.LoadOption{caches.WithLoadGroup(group), caches.WithSoftTTL(30 * time.Millisecond)}
+
+	actual, err := caches.GetOrLoad(context.Background(), cache, "key", time.Minute, loader, opts...)
+	assert.NilError(t, err)
+	assert.Equal(t, int32(1), actual, "initial load")
+
+	actual, err = caches.GetOrLoad(context.Background(), cache, "key", time.Minute, loader, opts...)
+	assert.NilError(t, err)
+	assert.Equal(t, int32(1), actual, "fresh value")
+
+	time.Sleep(40 * time.Millisecond)
+
+	for i := 0; i < 5; i++ {
+		actual, err = caches.GetOrLoad(context.Background(), cache, "key", time.Minute, loader, opts...)
+		assert.NilError(t, err)
+		assert.Equal(t, int32(1), actual, "stale value")
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for actual != 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+		actual, err = caches.GetOrLoad(context.Background(), cache, "key", time.Minute, loader, opts...)
+		assert.NilError(t, err)
+	}
+
+	assert.Equal(t, int32(2), actual, "refreshed value")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestGetOrLoadStaleRefreshError(t *testing.T) {
+	cache := memory.NewCache(context.Background(), 0)
+	loaderErr := errors.New("refresh failure")
+	handled := make(chan error, 1)
+	var calls int32
+
+	loader := func(ctx context.Context, key string) (string, error) {
+		if atomic.AddInt32(&calls, 1) > 1 {
+			return "", loaderErr
+		}
+		return "foo", nil
+	}
+	opts := []caches.LoadOption{
+		caches.WithLoadGroup(caches.NewLoadGroup()),
+		caches.WithSoftTTL(time.Millisecond),
+		caches.WithErrorHandler(func(err error) { handled <- err }),
+	}
+
+	_, err := caches.GetOrLoad(context.Background(), cache, "key", time.Minute, loader, opts...)
+	assert.NilError(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	actual, err := caches.GetOrLoad(context.Background(), cache, "key", time.Minute, loader, opts...)
+	assert.NilError(t, err)
+	assert.Equal(t, "foo", actual)
+	assert.ErrorIs(t, <-handled, loaderErr)
+
+	actual, err = caches.GetOrLoad(context.Background(), cache, "key", time.Minute, loader, opts...)
+	assert.NilError(t, err)
+	assert.Equal(t, "foo", actual, "stale value kept")
+}
+
+func TestGetOrLoadNegativeCaching(t *testing.T) {
+	cache := memory.NewCache(context.Background(), 0)
+	loaderCounter := assert.Count(t, 2)
+	loader := func(ctx context.Context, key string) (string, error) {
+		loaderCounter.Inc()
+		return "", caches.ErrNotFound
+	}
+	opts := []caches.LoadOption{caches.WithLoadGroup(caches.NewLoadGroup()), caches.WithNegativeTTL(20 * time.Millisecond)}
+
+	for i := 0; i < 3; i++ {
+		_, err := caches.GetOrLoad(context.Background(), cache, "key", time.Minute, loader, opts...)
+		assert.ErrorIs(t, err, caches.ErrNotFound)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+
+	_, err := caches.GetOrLoad(context.Background(), cache, "key", time.Minute, loader, opts...)
+	assert.ErrorIs(t, err, caches.ErrNotFound, "after negative ttl")
+}
@@ -0,0 +1,308 @@
+// Package memory provides an in-memory implementation of caches.Cache with ttl support,
+// background removal of expired entries and optional LRU eviction bounded by the entries count.
+package memory
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/caches"
+)
+
+var (
+	_ caches.Cache = (*memoryCache)(nil)
+)
+
+var (
+	ErrNotAssignable = errors.New("cached value is not assignable to specified pointer")
+)
+
+// EvictionReason describes why entry was removed from cache.
+type EvictionReason int
+
+const (
+	// EvictionExpired means entry exceeded its ttl.
+	EvictionExpired EvictionReason = iota
+	// EvictionCapacity means entry was the least recently used one when the size limit was exceeded.
+	EvictionCapacity
+	// EvictionDeleted means entry was removed with Delete.
+	EvictionDeleted
+	// EvictionReplaced means entry value was replaced with Set.
+	EvictionReplaced
+)
+
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictionExpired:
+		return "expired"
+	case EvictionCapacity:
+		return "capacity"
+	case EvictionDeleted:
+		return "deleted"
+	case EvictionReplaced:
+		return "replaced"
+	default:
+		return "unknown"
+	}
+}
+
+// EvictFunc is a function called after entry was removed or replaced in cache.
+type EvictFunc func(key string, value interface{}, reason EvictionReason)
+
+type eviction struct {
+	key    string
+	value  interface{}
+	reason EvictionReason
+}
+
+type entry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+// expired reports whether the entry has ttl set and it's already exceeded at now time.
+func (e *entry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+type memoryCache struct {
+	mu      sync.Mutex
+	items   map[string]*list.Element
+	order   *list.List
+	maxSize int
+	onEvict EvictFunc
+	evicted []eviction
+}
+
+func (c *memoryCache) Set(key string, value interface{}, ttl time.Duration) error {
+	var expiresAt time.Time
+	if ttl != caches.NoExpiration {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	c.mu.Lock()
+	defer c.unlock()
+
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*entry)
+		c.evict(e, EvictionReplaced)
+		e.value = value
+		e.expiresAt = expiresAt
+		c.order.MoveToFront(el)
+		return nil
+	}
+
+	c.items[key] = c.order.PushFront(&entry{
+		key:       key,
+		value:     value,
+		expiresAt: expiresAt,
+	})
+
+	if c.maxSize > 0 {
+		for c.order.Len() > c.maxSize {
+			c.remove(c.order.Back(), EvictionCapacity)
+		}
+	}
+
+	return nil
+}
+
+func (c *memoryCache) Get(key string, v interface{}) error {
+	if v == nil {
+		return caches.ErrNotPointer
+	}
+
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return caches.ErrNotPointer
+	}
+
+	c.mu.Lock()
+	defer c.unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return caches.ErrNotFound
+	}
+
+	e := el.Value.(*entry)
+	if e.expired(time.Now()) {
+		c.remove(el, EvictionExpired)
+		return caches.ErrNotFound
+	}
+
+	if err := assign(target.Elem(), e.value); err != nil {
+		return err
+	}
+
+	c.order.MoveToFront(el)
+	return nil
+}
+
+func (c *memoryCache) Delete(key string) error {
+	c.mu.Lock()
+	defer c.unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return caches.ErrNotFound
+	}
+
+	if el.Value.(*entry).expired(time.Now()) {
+		c.remove(el, EvictionExpired)
+		return caches.ErrNotFound
+	}
+
+	c.remove(el, EvictionDeleted)
+	return nil
+}
+
+// remove deletes element from both lookup map and LRU list. It must be called with acquired lock.
+func (c *memoryCache) remove(el *list.Element, reason EvictionReason) {
+	e := c.order.Remove(el).(*entry)
+	delete(c.items, e.key)
+	c.evict(e, reason)
+}
+
+// evict queues eviction to be reported to EvictFunc on unlock. It must be called with acquired lock.
+func (c *memoryCache) evict(e *entry, reason EvictionReason) {
+	if c.onEvict == nil {
+		return
+	}
+	c.evicted = append(c.evicted, eviction{key: e.key, value: e.value, reason: reason})
+}
+
+// unlock releases the lock and reports queued evictions afterwards, so EvictFunc can safely use the cache.
+func (c *memoryCache) unlock() {
+	evicted := c.evicted
+	c.evicted = nil
+	c.mu.Unlock()
+
+	for _, e := range evicted {
+		c.onEvict(e.key, e.value, e.reason)
+	}
+}
+
+func (c *memoryCache) cleanup() {
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.unlock()
+
+	for el := c.order.Back(); el != nil; {
+		prev := el.Prev()
+		if el.Value.(*entry).expired(now) {
+			c.remove(el, EvictionExpired)
+		}
+		el = prev
+	}
+}
+
+// assign sets value to target. If value type is not directly assignable but it's convertible
+// to target type, the converted value is set. Otherwise ErrNotAssignable is returned.
+func assign(target reflect.Value, value interface{}) error {
+	if value == nil {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+
+	val := reflect.ValueOf(value)
+	valType := val.Type()
+	targetType := target.Type()
+
+	if valType.AssignableTo(targetType) {
+		target.Set(val)
+		return nil
+	}
+
+	if valType.Kind() == reflect.Pointer && valType.Elem().AssignableTo(targetType) {
+		if val.IsNil() {
+			target.Set(reflect.Zero(targetType))
+			return nil
+		}
+		target.Set(val.Elem())
+		return nil
+	}
+
+	if valType.ConvertibleTo(targetType) {
+		target.Set(val.Convert(targetType))
+		return nil
+	}
+
+	return ErrNotAssignable
+}
+
+type Options struct {
+	MaxSize int
+	OnEvict EvictFunc
+}
+
+type Option func(o *Options)
+
+// WithMaxSize sets the maximum number of entries stored in cache. When the limit is exceeded
+// the least recently used entry is evicted. Value less or equal 0 means there is no limit.
+func WithMaxSize(size int) Option {
+	return func(o *Options) {
+		o.MaxSize = size
+	}
+}
+
+// WithOnEvict sets EvictFunc called after entry was removed or replaced in cache with the reason of eviction.
+// It's called after the lock is released, so it's safe to use the cache inside the function.
+func WithOnEvict(onEvict EvictFunc) Option {
+	return func(o *Options) {
+		o.OnEvict = onEvict
+	}
+}
+
+func NewOptions(opts ...Option) *Options {
+	o := &Options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// NewCache returns a caches.Cache which stores values in memory guarded with mutex for thread safety.
+// Get assigns the stored value to the pointer directly, it does not copy the value deeply, so storing
+// reference types like maps or slices shares them between callers.
+// It'll create a single goroutine to perform cleanup with provided cleanupInterval to remove expired entries.
+// If cleanupInterval is less or equal 0, the cleanup goroutine will not run and expired entries
+// are removed only when they're accessed or evicted by the size limit. The goroutine stops when ctx is done.
+func NewCache(ctx context.Context, cleanupInterval time.Duration, opts ...Option) caches.Cache {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	options := NewOptions(opts...)
+
+	cache := &memoryCache{
+		items:   make(map[string]*list.Element),
+		order:   list.New(),
+		maxSize: options.MaxSize,
+		onEvict: options.OnEvict,
+	}
+
+	if cleanupInterval > time.Duration(0) {
+		go func() {
+			ticker := time.NewTicker(cleanupInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					cache.cleanup()
+				}
+			}
+		}()
+	}
+
+	return cache
+}
@@ -0,0 +1,280 @@
+package memory_test
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/caches"
+	"github.com/Prastiwar/Go-flow/caches/cachetest"
+	"github.com/Prastiwar/Go-flow/caches/memory"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+func TestConformance(t *testing.T) {
+	cachetest.TestCache(t, func() caches.Cache {
+		return memory.NewCache(context.Background(), 0)
+	})
+}
+
+func TestSetGet(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		target  func() interface{}
+		want    interface{}
+		wantErr error
+	}{
+		{
+			name:   "success-string",
+			value:  "foo",
+			target: func() interface{} { return new(string) },
+			want:   "foo",
+		},
+		{
+			name:   "success-convertible",
+			value:  int32(5),
+			target: func() interface{} { return new(int64) },
+			want:   int64(5),
+		},
+		{
+			name:   "success-pointer-value",
+			value:  &struct{ Foo string }{Foo: "bar"},
+			target: func() interface{} { return new(struct{ Foo string }) },
+			want:   struct{ Foo string }{Foo: "bar"},
+		},
+		{
+			name:   "success-interface",
+			value:  1,
+			target: func() interface{} { return new(interface{}) },
+			want:   1,
+		},
+		{
+			name:    "invalid-nil",
+			value:   "foo",
+			target:  func() interface{} { return nil },
+			wantErr: caches.ErrNotPointer,
+		},
+		{
+			name:    "invalid-not-pointer",
+			value:   "foo",
+			target:  func() interface{} { return "" },
+			wantErr: caches.ErrNotPointer,
+		},
+		{
+			name:    "invalid-nil-pointer",
+			value:   "foo",
+			target:  func() interface{} { var s *string; return s },
+			wantErr: caches.ErrNotPointer,
+		},
+		{
+			name:    "invalid-not-assignable",
+			value:   "foo",
+			target:  func() interface{} { return new(struct{}) },
+			wantErr: memory.ErrNotAssignable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := memory.NewCache(context.Background(), 0)
+
+			err := cache.Set("key", tt.value, caches.NoExpiration)
+			assert.NilError(t, err)
+
+			target := tt.target()
+			err = cache.Get("key", target)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			if t.Failed() {
+				t.FailNow()
+			}
+
+			assert.Equal(t, tt.want, reflect.ValueOf(target).Elem().Interface())
+		})
+	}
+}
+
+func TestSetReplace(t *testing.T) {
+	cache := memory.NewCache(context.Background(), 0)
+
+	err := cache.Set("key", "foo", time.Millisecond)
+	assert.NilError(t, err)
+
+	err = cache.Set("key", "bar", caches.NoExpiration)
+	assert.NilError(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	var actual string
+	err = cache.Get("key", &actual)
+	assert.NilError(t, err)
+	assert.Equal(t, "bar", actual)
+}
+
+func TestGetNotFound(t *testing.T) {
+	cache := memory.NewCache(context.Background(), 0)
+
+	var actual string
+	err := cache.Get("key", &actual)
+
+	assert.ErrorIs(t, err, caches.ErrNotFound)
+}
+
+func TestDelete(t *testing.T) {
+	cache := memory.NewCache(context.Background(), 0)
+
+	err := cache.Delete("key")
+	assert.ErrorIs(t, err, caches.ErrNotFound, "missing key")
+
+	err = cache.Set("key", "foo", caches.NoExpiration)
+	assert.NilError(t, err)
+
+	err = cache.Delete("key")
+	assert.NilError(t, err, "existing key")
+
+	var actual string
+	err = cache.Get("key", &actual)
+	assert.ErrorIs(t, err, caches.ErrNotFound, "deleted key")
+}
+
+func TestExpiration(t *testing.T) {
+	cache := memory.NewCache(context.Background(), 0)
+
+	err := cache.Set("key", "foo", 10*time.Millisecond)
+	assert.NilError(t, err)
+
+	var actual string
+	err = cache.Get("key", &actual)
+	assert.NilError(t, err, "before expiration")
+	assert.Equal(t, "foo", actual)
+
+	time.Sleep(20 * time.Millisecond)
+
+	err = cache.Get("key", &actual)
+	assert.ErrorIs(t, err, caches.ErrNotFound, "after expiration")
+
+	err = cache.Delete("key")
+	assert.ErrorIs(t, err, caches.ErrNotFound, "delete after expiration")
+}
+
+func TestMaxSizeEviction(t *testing.T) {
+	cache := memory.NewCache(context.Background(), 0, memory.WithMaxSize(2))
+
+	assert.NilError(t, cache.Set("a", 1, caches.NoExpiration))
+	assert.NilError(t, cache.Set("b", 2, caches.NoExpiration))
+
+	// touch "a" so "b" becomes the least recently used
+	var actual int
+	assert.NilError(t, cache.Get("a", &actual))
+
+	assert.NilError(t, cache.Set("c", 3, caches.NoExpiration))
+
+	assert.ErrorIs(t, cache.Get("b", &actual), caches.ErrNotFound, "evicted key")
+	assert.NilError(t, cache.Get("a", &actual), "recently used key")
+	assert.Equal(t, 1, actual)
+	assert.NilError(t, cache.Get("c", &actual), "new key")
+	assert.Equal(t, 3, actual)
+}
+
+func TestCleanup(t *testing.T) {
+	goroutinesCount := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cache := memory.NewCache(ctx, 10*time.Millisecond, memory.WithMaxSize(1))
+	assert.Equal(t, goroutinesCount+1, runtime.NumGoroutine())
+
+	err := cache.Set("key", "foo", 5*time.Millisecond)
+	assert.NilError(t, err)
+
+	time.Sleep(30 * time.Millisecond)
+
+	var actual string
+	err = cache.Get("key", &actual)
+	assert.ErrorIs(t, err, caches.ErrNotFound)
+
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, goroutinesCount, runtime.NumGoroutine())
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	cache := memory.NewCache(context.Background(), time.Millisecond, memory.WithMaxSize(50))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				key := strconv.Itoa(j)
+				_ = cache.Set(key, i, time.Millisecond)
+				var v int
+				_ = cache.Get(key, &v)
+				_ = cache.Delete(key)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestOnEvict(t *testing.T) {
+	type evicted struct {
+		key    string
+		value  interface{}
+		reason memory.EvictionReason
+	}
+
+	var actual []evicted
+	var cache caches.Cache
+	cache = memory.NewCache(context.Background(), 0,
+		memory.WithMaxSize(2),
+		memory.WithOnEvict(func(key string, value interface{}, reason memory.EvictionReason) {
+			actual = append(actual, evicted{key, value, reason})
+			// cache must be usable inside the callback
+			var v int
+			_ = cache.Get(key, &v)
+		}),
+	)
+
+	assert.NilError(t, cache.Set("a", 1, caches.NoExpiration))
+	assert.NilError(t, cache.Set("a", 2, caches.NoExpiration))
+	assert.NilError(t, cache.Set("b", 3, time.Millisecond))
+	assert.NilError(t, cache.Set("c", 4, caches.NoExpiration))
+	assert.NilError(t, cache.Delete("c"))
+	assert.NilError(t, cache.Set("d", 5, time.Millisecond))
+
+	time.Sleep(5 * time.Millisecond)
+
+	var v int
+	assert.ErrorIs(t, cache.Get("d", &v), caches.ErrNotFound)
+
+	expected := []evicted{
+		{"a", 1, memory.EvictionReplaced},
+		{"a", 2, memory.EvictionCapacity},
+		{"c", 4, memory.EvictionDeleted},
+		{"d", 5, memory.EvictionExpired},
+	}
+	assert.Equal(t, len(expected), len(actual))
+	if t.Failed() {
+		t.FailNow()
+	}
+	for i := range expected {
+		assert.Equal(t, expected[i], actual[i], strconv.Itoa(i))
+	}
+}
+
+func TestEvictionReasonString(t *testing.T) {
+	assert.Equal(t, "expired", memory.EvictionExpired.String())
+	assert.Equal(t, "capacity", memory.EvictionCapacity.String())
+	assert.Equal(t, "deleted", memory.EvictionDeleted.String())
+	assert.Equal(t, "replaced", memory.EvictionReplaced.String())
+	assert.Equal(t, "unknown", memory.EvictionReason(-1).String())
+}
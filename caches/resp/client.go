@@ -0,0 +1,310 @@
+// Package resp provides caches.DistributedCache implementation communicating over RESP protocol
+// used by Redis and compatible servers. It's implemented using only the standard library and
+// ships with in-process test server, so the client can be tested without a real server.
+package resp
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/caches"
+	"github.com/Prastiwar/Go-flow/datas"
+)
+
+var (
+	_ caches.DistributedCache = (*Client)(nil)
+)
+
+var (
+	ErrClosed           = errors.New("client is closed")
+	ErrUnexpectedReply  = errors.New("unexpected reply type")
+	ErrMissingFormatter = errors.New("nil ByteFormatter was passed to constructor")
+	ErrInvalidPoolSize  = errors.New("pool size must be greater than 0")
+)
+
+const (
+	defaultPoolSize      = 10
+	defaultDialTimeout   = 5 * time.Second
+	minimumExpirationTTL = time.Millisecond
+)
+
+type Options struct {
+	PoolSize    int
+	DialTimeout time.Duration
+}
+
+type Option func(o *Options)
+
+// WithPoolSize sets the maximum number of open connections. Callers wait for released connection
+// when the limit is reached. Default pool size is 10.
+func WithPoolSize(size int) Option {
+	return func(o *Options) {
+		o.PoolSize = size
+	}
+}
+
+// WithDialTimeout sets the timeout for establishing new connection. Default timeout is 5 seconds.
+func WithDialTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.DialTimeout = timeout
+	}
+}
+
+func NewOptions(opts ...Option) *Options {
+	o := &Options{
+		PoolSize:    defaultPoolSize,
+		DialTimeout: defaultDialTimeout,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+type conn struct {
+	netConn net.Conn
+	r       *bufio.Reader
+	w       *bufio.Writer
+}
+
+// Client is a caches.DistributedCache which stores values encoded with datas.ByteFormatter on RESP server.
+// Connections are established lazily and kept in pool for reuse. Every call respects ctx deadline and cancellation.
+type Client struct {
+	addr      string
+	formatter datas.ByteFormatter
+	dialer    net.Dialer
+
+	slots chan struct{}
+
+	mu     sync.Mutex
+	idle   []*conn
+	closed bool
+}
+
+// NewClient returns a new Client for server listening at addr. No connection is established until the first call.
+func NewClient(addr string, formatter datas.ByteFormatter, opts ...Option) (*Client, error) {
+	if formatter == nil {
+		return nil, ErrMissingFormatter
+	}
+
+	options := NewOptions(opts...)
+	if options.PoolSize <= 0 {
+		return nil, ErrInvalidPoolSize
+	}
+
+	return &Client{
+		addr:      addr,
+		formatter: formatter,
+		dialer:    net.Dialer{Timeout: options.DialTimeout},
+		slots:     make(chan struct{}, options.PoolSize),
+	}, nil
+}
+
+// Set stores value encoded with formatter. TTL is sent with millisecond precision and it's rounded up to 1ms.
+func (c *Client) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	b, err := c.formatter.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	args := [][]byte{[]byte("SET"), []byte(key), b}
+	if ttl != caches.NoExpiration {
+		if ttl < minimumExpirationTTL {
+			ttl = minimumExpirationTTL
+		}
+		args = append(args, []byte("PX"), []byte(strconv.FormatInt(ttl.Milliseconds(), 10)))
+	}
+
+	reply, err := c.do(ctx, args...)
+	if err != nil {
+		return err
+	}
+
+	if reply != "OK" {
+		return ErrUnexpectedReply
+	}
+	return nil
+}
+
+// Get decodes value stored with key into v. Null reply is mapped to caches.ErrNotFound.
+func (c *Client) Get(ctx context.Context, key string, v interface{}) error {
+	if v == nil {
+		return caches.ErrNotPointer
+	}
+	if val := reflect.ValueOf(v); val.Kind() != reflect.Pointer || val.IsNil() {
+		return caches.ErrNotPointer
+	}
+
+	reply, err := c.do(ctx, []byte("GET"), []byte(key))
+	if err != nil {
+		return err
+	}
+
+	if reply == nil {
+		return caches.ErrNotFound
+	}
+
+	b, ok := reply.([]byte)
+	if !ok {
+		return ErrUnexpectedReply
+	}
+	return c.formatter.Unmarshal(b, v)
+}
+
+// Delete removes value stored with key. If server did not delete any key, caches.ErrNotFound is returned.
+func (c *Client) Delete(ctx context.Context, key string) error {
+	reply, err := c.do(ctx, []byte("DEL"), []byte(key))
+	if err != nil {
+		return err
+	}
+
+	n, ok := reply.(int64)
+	if !ok {
+		return ErrUnexpectedReply
+	}
+
+	if n == 0 {
+		return caches.ErrNotFound
+	}
+	return nil
+}
+
+// Close closes all idle connections and prevents new calls. Connections in use are closed when they're released.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+
+	var errs error
+	for _, cn := range c.idle {
+		errs = errors.Join(errs, cn.netConn.Close())
+	}
+	c.idle = nil
+
+	return errs
+}
+
+// do sends command with args and returns the reply. ErrorReply is returned as error.
+func (c *Client) do(ctx context.Context, args ...[]byte) (interface{}, error) {
+	cn, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stop := c.watch(ctx, cn)
+
+	var reply interface{}
+	err = writeCommand(cn.w, args...)
+	if err == nil {
+		reply, err = readValue(cn.r)
+	}
+
+	interrupted := stop()
+	if err != nil {
+		if interrupted {
+			err = ctx.Err()
+		}
+
+		var errReply ErrorReply
+		if errors.As(err, &errReply) {
+			c.release(cn)
+		} else {
+			c.discard(cn)
+		}
+		return nil, err
+	}
+
+	c.release(cn)
+	return reply, nil
+}
+
+// watch applies ctx deadline to connection and interrupts it when ctx is done. Returned function must be called
+// after the call is finished and it reports whether the connection was interrupted by ctx.
+func (c *Client) watch(ctx context.Context, cn *conn) func() bool {
+	deadline, _ := ctx.Deadline()
+	_ = cn.netConn.SetDeadline(deadline)
+
+	done := make(chan struct{})
+	interrupted := make(chan bool, 1)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = cn.netConn.SetDeadline(time.Now())
+			interrupted <- true
+		case <-done:
+			interrupted <- false
+		}
+	}()
+
+	return func() bool {
+		close(done)
+		return <-interrupted || ctx.Err() != nil
+	}
+}
+
+// acquire returns idle connection or dials a new one if pool limit is not reached. Otherwise it waits for
+// released connection until ctx is done.
+func (c *Client) acquire(ctx context.Context) (*conn, error) {
+	select {
+	case c.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		<-c.slots
+		return nil, ErrClosed
+	}
+
+	if n := len(c.idle); n > 0 {
+		cn := c.idle[n-1]
+		c.idle = c.idle[:n-1]
+		c.mu.Unlock()
+		return cn, nil
+	}
+	c.mu.Unlock()
+
+	netConn, err := c.dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		<-c.slots
+		return nil, err
+	}
+
+	return &conn{
+		netConn: netConn,
+		r:       bufio.NewReader(netConn),
+		w:       bufio.NewWriter(netConn),
+	}, nil
+}
+
+// release puts healthy connection back to idle pool.
+func (c *Client) release(cn *conn) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		c.discard(cn)
+		return
+	}
+	c.idle = append(c.idle, cn)
+	c.mu.Unlock()
+
+	<-c.slots
+}
+
+// discard closes broken connection and frees its slot.
+func (c *Client) discard(cn *conn) {
+	_ = cn.netConn.Close()
+	<-c.slots
+}
@@ -0,0 +1,143 @@
+package resp_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/caches"
+	"github.com/Prastiwar/Go-flow/caches/cachetest"
+	"github.com/Prastiwar/Go-flow/caches/resp"
+	"github.com/Prastiwar/Go-flow/datas"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+	"github.com/Prastiwar/Go-flow/tests/mocks"
+)
+
+// newClient starts resp.TestServer and returns resp.Client connected to it. Both are closed on test cleanup.
+func newClient(t *testing.T, opts ...resp.Option) (*resp.Client, *resp.TestServer) {
+	server, err := resp.NewTestServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := resp.NewClient(server.Addr(), datas.Json(), opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = server.Close()
+	})
+
+	return client, server
+}
+
+func TestConformance(t *testing.T) {
+	cachetest.TestDistributedCache(t, func() caches.DistributedCache {
+		client, _ := newClient(t)
+		return client
+	})
+}
+
+func TestNewClientValidation(t *testing.T) {
+	t.Run("nil-formatter", func(t *testing.T) {
+		_, err := resp.NewClient("127.0.0.1:6379", nil)
+		assert.ErrorIs(t, err, resp.ErrMissingFormatter)
+	})
+
+	t.Run("invalid-pool-size", func(t *testing.T) {
+		_, err := resp.NewClient("127.0.0.1:6379", datas.Json(), resp.WithPoolSize(0))
+		assert.ErrorIs(t, err, resp.ErrInvalidPoolSize)
+	})
+}
+
+func TestConnectionPooling(t *testing.T) {
+	ctx := context.Background()
+	client, server := newClient(t, resp.WithPoolSize(2))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				assert.NilError(t, client.Set(ctx, "key", j, caches.NoExpiration))
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, true, server.Connections() <= 2, "connections count")
+}
+
+func TestFormatterError(t *testing.T) {
+	formatErr := errors.New("format failure")
+	server, err := resp.NewTestServer()
+	assert.NilError(t, err)
+	defer server.Close()
+
+	client, err := resp.NewClient(server.Addr(), mocks.ByteIOFormatterMock{
+		OnMarshal: func(v any) ([]byte, error) {
+			return nil, formatErr
+		},
+	})
+	assert.NilError(t, err)
+	defer client.Close()
+
+	err = client.Set(context.Background(), "key", 1, caches.NoExpiration)
+	assert.ErrorIs(t, err, formatErr)
+}
+
+func TestContextDeadline(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NilError(t, err)
+	defer l.Close()
+
+	// server which accepts connections and never replies
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			defer c.Close()
+		}
+	}()
+
+	client, err := resp.NewClient(l.Addr().String(), datas.Json())
+	assert.NilError(t, err)
+	defer client.Close()
+
+	t.Run("deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		var actual int
+		err := client.Get(ctx, "key", &actual)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(20*time.Millisecond, cancel)
+
+		var actual int
+		err := client.Get(ctx, "key", &actual)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestClosed(t *testing.T) {
+	client, _ := newClient(t)
+
+	assert.NilError(t, client.Set(context.Background(), "key", 1, caches.NoExpiration))
+	assert.NilError(t, client.Close())
+	assert.NilError(t, client.Close(), "second close")
+
+	err := client.Set(context.Background(), "key", 1, caches.NoExpiration)
+	assert.ErrorIs(t, err, resp.ErrClosed)
+}
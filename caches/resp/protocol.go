@@ -0,0 +1,129 @@
+package resp
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var (
+	ErrProtocol = errors.New("invalid RESP protocol message")
+)
+
+// ErrorReply is an error message replied by the server.
+type ErrorReply string
+
+func (e ErrorReply) Error() string {
+	return string(e)
+}
+
+// writeCommand writes args as RESP array of bulk strings and flushes the writer.
+func writeCommand(w *bufio.Writer, args ...[]byte) error {
+	if _, err := fmt.Fprintf(w, "*%d\r\n", len(args)); err != nil {
+		return err
+	}
+
+	for _, arg := range args {
+		if err := writeBulk(w, arg); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+// writeBulk writes b as RESP bulk string. Nil b is written as null bulk string.
+func writeBulk(w *bufio.Writer, b []byte) error {
+	if b == nil {
+		_, err := w.WriteString("$-1\r\n")
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "$%d\r\n", len(b)); err != nil {
+		return err
+	}
+	if _, err := w.Write(b); err != nil {
+		return err
+	}
+	_, err := w.WriteString("\r\n")
+	return err
+}
+
+// readValue reads a single RESP value. Simple string is returned as string, integer as int64, bulk string as []byte
+// and array as []interface{}. Null bulk string and null array are returned as nil. Error is returned as ErrorReply.
+func readValue(r *bufio.Reader) (interface{}, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(line) == 0 {
+		return nil, ErrProtocol
+	}
+
+	switch line[0] {
+	case '+':
+		return string(line[1:]), nil
+	case '-':
+		return nil, ErrorReply(line[1:])
+	case ':':
+		n, err := strconv.ParseInt(string(line[1:]), 10, 64)
+		if err != nil {
+			return nil, ErrProtocol
+		}
+		return n, nil
+	case '$':
+		n, err := strconv.Atoi(string(line[1:]))
+		if err != nil || n < -1 {
+			return nil, ErrProtocol
+		}
+		if n == -1 {
+			return nil, nil
+		}
+
+		b := make([]byte, n+2)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		if b[n] != '\r' || b[n+1] != '\n' {
+			return nil, ErrProtocol
+		}
+		return b[:n], nil
+	case '*':
+		n, err := strconv.Atoi(string(line[1:]))
+		if err != nil || n < -1 {
+			return nil, ErrProtocol
+		}
+		if n == -1 {
+			return nil, nil
+		}
+
+		arr := make([]interface{}, n)
+		for i := range arr {
+			v, err := readValue(r)
+			if err != nil {
+				return nil, err
+			}
+			arr[i] = v
+		}
+		return arr, nil
+	default:
+		return nil, ErrProtocol
+	}
+}
+
+// readLine reads line terminated with CRLF and returns it without the terminator.
+func readLine(r *bufio.Reader) ([]byte, error) {
+	line, err := r.ReadBytes('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(line)
+	if n < 2 || line[n-2] != '\r' {
+		return nil, ErrProtocol
+	}
+	return line[:n-2], nil
+}
@@ -0,0 +1,55 @@
+package resp
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+func TestWriteCommand(t *testing.T) {
+	var b bytes.Buffer
+	w := bufio.NewWriter(&b)
+
+	err := writeCommand(w, []byte("SET"), []byte("key"), []byte(""))
+
+	assert.NilError(t, err)
+	assert.Equal(t, "*3\r\n$3\r\nSET\r\n$3\r\nkey\r\n$0\r\n\r\n", b.String())
+}
+
+func TestReadValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    interface{}
+		wantErr error
+	}{
+		{name: "simple", input: "+OK\r\n", want: "OK"},
+		{name: "integer", input: ":-5\r\n", want: int64(-5)},
+		{name: "bulk", input: "$3\r\nfoo\r\n", want: []byte("foo")},
+		{name: "null-bulk", input: "$-1\r\n", want: nil},
+		{name: "null-array", input: "*-1\r\n", want: nil},
+		{name: "array", input: "*2\r\n+OK\r\n:1\r\n", want: []interface{}{"OK", int64(1)}},
+		{name: "error", input: "-ERR failure\r\n", wantErr: ErrorReply("ERR failure")},
+		{name: "invalid-type", input: "?\r\n", wantErr: ErrProtocol},
+		{name: "invalid-terminator", input: "+OK\n", wantErr: ErrProtocol},
+		{name: "invalid-bulk-length", input: "$x\r\n", wantErr: ErrProtocol},
+		{name: "invalid-bulk-terminator", input: "$3\r\nfoo..", wantErr: ErrProtocol},
+		{name: "invalid-integer", input: ":x\r\n", wantErr: ErrProtocol},
+		{name: "invalid-empty", input: "\r\n", wantErr: ErrProtocol},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readValue(bufio.NewReader(strings.NewReader(tt.input)))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
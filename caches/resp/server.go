@@ -0,0 +1,258 @@
+package resp
+
+import (
+	"bufio"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type serverEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// TestServer is an in-process RESP server keeping values in memory. It supports PING, GET, SET with EX and PX
+// options, DEL and FLUSHALL commands which is enough to test Client without a real server.
+type TestServer struct {
+	listener net.Listener
+
+	mu      sync.Mutex
+	entries map[string]serverEntry
+	conns   map[net.Conn]struct{}
+	dialed  atomic.Int64
+
+	wg sync.WaitGroup
+}
+
+// NewTestServer starts TestServer listening on random port of loopback interface.
+// Use Addr to connect to it and Close to stop it.
+func NewTestServer() (*TestServer, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &TestServer{
+		listener: l,
+		entries:  make(map[string]serverEntry),
+		conns:    make(map[net.Conn]struct{}),
+	}
+
+	s.wg.Add(1)
+	go s.serve()
+
+	return s, nil
+}
+
+// Addr returns address the server listens on.
+func (s *TestServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Connections returns the number of connections accepted since server started.
+func (s *TestServer) Connections() int {
+	return int(s.dialed.Load())
+}
+
+// Close stops the server and closes all open connections.
+func (s *TestServer) Close() error {
+	err := s.listener.Close()
+
+	s.mu.Lock()
+	for c := range s.conns {
+		_ = c.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return err
+}
+
+func (s *TestServer) serve() {
+	defer s.wg.Done()
+
+	for {
+		c, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.dialed.Add(1)
+		s.mu.Lock()
+		s.conns[c] = struct{}{}
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go s.handle(c)
+	}
+}
+
+func (s *TestServer) handle(c net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		s.mu.Unlock()
+		_ = c.Close()
+	}()
+
+	r := bufio.NewReader(c)
+	w := bufio.NewWriter(c)
+
+	for {
+		cmd, err := readValue(r)
+		if err != nil {
+			return
+		}
+
+		args, ok := commandArgs(cmd)
+		if !ok {
+			_ = writeError(w, "ERR protocol error")
+			return
+		}
+
+		if err := s.exec(w, args); err != nil {
+			return
+		}
+	}
+}
+
+// commandArgs converts command read as RESP array to its arguments.
+func commandArgs(cmd interface{}) ([]string, bool) {
+	arr, ok := cmd.([]interface{})
+	if !ok || len(arr) == 0 {
+		return nil, false
+	}
+
+	args := make([]string, len(arr))
+	for i, v := range arr {
+		b, ok := v.([]byte)
+		if !ok {
+			return nil, false
+		}
+		args[i] = string(b)
+	}
+	return args, true
+}
+
+func (s *TestServer) exec(w *bufio.Writer, args []string) error {
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return writeSimple(w, "PONG")
+	case "GET":
+		if len(args) != 2 {
+			return writeError(w, "ERR wrong number of arguments for 'get' command")
+		}
+		return s.get(w, args[1])
+	case "SET":
+		if len(args) != 3 && len(args) != 5 {
+			return writeError(w, "ERR syntax error")
+		}
+		return s.set(w, args)
+	case "DEL":
+		if len(args) < 2 {
+			return writeError(w, "ERR wrong number of arguments for 'del' command")
+		}
+		return s.del(w, args[1:])
+	case "FLUSHALL":
+		s.mu.Lock()
+		s.entries = make(map[string]serverEntry)
+		s.mu.Unlock()
+		return writeSimple(w, "OK")
+	default:
+		return writeError(w, "ERR unknown command '"+args[0]+"'")
+	}
+}
+
+func (s *TestServer) get(w *bufio.Writer, key string) error {
+	s.mu.Lock()
+	e, ok := s.lookup(key)
+	s.mu.Unlock()
+
+	if !ok {
+		return flush(w, writeBulk(w, nil))
+	}
+	return flush(w, writeBulk(w, e.value))
+}
+
+func (s *TestServer) set(w *bufio.Writer, args []string) error {
+	e := serverEntry{value: []byte(args[2])}
+
+	if len(args) == 5 {
+		n, err := strconv.ParseInt(args[4], 10, 64)
+		if err != nil || n <= 0 {
+			return writeError(w, "ERR invalid expire time in 'set' command")
+		}
+
+		switch strings.ToUpper(args[3]) {
+		case "EX":
+			e.expiresAt = time.Now().Add(time.Duration(n) * time.Second)
+		case "PX":
+			e.expiresAt = time.Now().Add(time.Duration(n) * time.Millisecond)
+		default:
+			return writeError(w, "ERR syntax error")
+		}
+	}
+
+	s.mu.Lock()
+	s.entries[args[1]] = e
+	s.mu.Unlock()
+
+	return writeSimple(w, "OK")
+}
+
+func (s *TestServer) del(w *bufio.Writer, keys []string) error {
+	deleted := 0
+
+	s.mu.Lock()
+	for _, key := range keys {
+		if _, ok := s.lookup(key); ok {
+			delete(s.entries, key)
+			deleted++
+		}
+	}
+	s.mu.Unlock()
+
+	return writeInteger(w, deleted)
+}
+
+// lookup returns entry which has not expired yet. It must be called with acquired lock.
+func (s *TestServer) lookup(key string) (serverEntry, bool) {
+	e, ok := s.entries[key]
+	if !ok {
+		return e, false
+	}
+
+	if !e.expiresAt.IsZero() && !time.Now().Before(e.expiresAt) {
+		delete(s.entries, key)
+		return e, false
+	}
+	return e, true
+}
+
+func writeSimple(w *bufio.Writer, s string) error {
+	_, err := w.WriteString("+" + s + "\r\n")
+	return flush(w, err)
+}
+
+func writeError(w *bufio.Writer, msg string) error {
+	_, err := w.WriteString("-" + msg + "\r\n")
+	return flush(w, err)
+}
+
+func writeInteger(w *bufio.Writer, n int) error {
+	_, err := w.WriteString(":" + strconv.Itoa(n) + "\r\n")
+	return flush(w, err)
+}
+
+// flush flushes w if err is nil, otherwise err is returned.
+func flush(w *bufio.Writer, err error) error {
+	if err != nil {
+		return err
+	}
+	return w.Flush()
+}
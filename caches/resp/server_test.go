@@ -0,0 +1,68 @@
+package resp_test
+
+import (
+	"bufio"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/caches/resp"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+func TestTestServerCommands(t *testing.T) {
+	server, err := resp.NewTestServer()
+	assert.NilError(t, err)
+	defer server.Close()
+
+	c, err := net.Dial("tcp", server.Addr())
+	assert.NilError(t, err)
+	defer c.Close()
+
+	r := bufio.NewReader(c)
+	send := func(cmd string) string {
+		_, err := c.Write([]byte(cmd))
+		assert.NilError(t, err)
+		line, err := r.ReadString('\n')
+		assert.NilError(t, err)
+		return line
+	}
+
+	tests := []struct {
+		name     string
+		cmd      string
+		expected string
+	}{
+		{"ping", "*1\r\n$4\r\nPING\r\n", "+PONG\r\n"},
+		{"set", "*3\r\n$3\r\nSET\r\n$3\r\nkey\r\n$3\r\nfoo\r\n", "+OK\r\n"},
+		{"get", "*2\r\n$3\r\nGET\r\n$3\r\nkey\r\n", "$3\r\n"},
+		{"del", "*3\r\n$3\r\nDEL\r\n$3\r\nkey\r\n$7\r\nmissing\r\n", ":1\r\n"},
+		{"get-missing", "*2\r\n$3\r\nGET\r\n$3\r\nkey\r\n", "$-1\r\n"},
+		{"set-ex", "*5\r\n$3\r\nSET\r\n$3\r\nkey\r\n$3\r\nfoo\r\n$2\r\nEX\r\n$1\r\n1\r\n", "+OK\r\n"},
+		{"set-invalid-expire", "*5\r\n$3\r\nSET\r\n$3\r\nkey\r\n$3\r\nfoo\r\n$2\r\nPX\r\n$1\r\n0\r\n", "-ERR invalid expire time in 'set' command\r\n"},
+		{"set-invalid-option", "*5\r\n$3\r\nSET\r\n$3\r\nkey\r\n$3\r\nfoo\r\n$2\r\nXX\r\n$1\r\n1\r\n", "-ERR syntax error\r\n"},
+		{"get-arguments", "*1\r\n$3\r\nGET\r\n", "-ERR wrong number of arguments for 'get' command\r\n"},
+		{"flushall", "*1\r\n$8\r\nFLUSHALL\r\n", "+OK\r\n"},
+		{"unknown", "*1\r\n$4\r\nINCR\r\n", "-ERR unknown command 'INCR'\r\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, send(tt.cmd))
+			if tt.name == "get" {
+				line, err := r.ReadString('\n')
+				assert.NilError(t, err)
+				assert.Equal(t, "foo\r\n", line)
+			}
+		})
+	}
+
+	t.Run("protocol-error", func(t *testing.T) {
+		assert.Equal(t, "-ERR protocol error\r\n", send("+PING\r\n"))
+
+		_ = c.SetReadDeadline(time.Now().Add(time.Second))
+		_, err := r.ReadByte()
+		assert.Error(t, err, "connection should be closed")
+	})
+
+	assert.Equal(t, 1, server.Connections())
+}
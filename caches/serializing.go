@@ -0,0 +1,115 @@
+package caches
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/datas"
+)
+
+var (
+	_ Cache            = (*serializingCache)(nil)
+	_ DistributedCache = (*serializingDistributedCache)(nil)
+)
+
+// ByteStore is an interface defining byte-oriented storage like bigcache which can be adapted to Cache
+// with NewSerializing. Get should return ErrNotFound if value was not set before and so should Delete.
+type ByteStore interface {
+	Set(key string, value []byte, ttl time.Duration) error
+	Get(key string) ([]byte, error)
+	Delete(key string) error
+}
+
+// DistributedByteStore is an interface defining byte-oriented distributed storage which can be adapted to DistributedCache
+// with NewSerializingDistributed. Get should return ErrNotFound if value was not set before and so should Delete.
+type DistributedByteStore interface {
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type serializingCache struct {
+	store     ByteStore
+	formatter datas.ByteFormatter
+}
+
+// NewSerializing returns a Cache which encodes values with formatter before storing them in ByteStore
+// and decodes them on Get.
+func NewSerializing(store ByteStore, formatter datas.ByteFormatter) Cache {
+	return &serializingCache{
+		store:     store,
+		formatter: formatter,
+	}
+}
+
+func (c *serializingCache) Set(key string, value interface{}, ttl time.Duration) error {
+	b, err := c.formatter.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return c.store.Set(key, b, ttl)
+}
+
+func (c *serializingCache) Get(key string, v interface{}) error {
+	if !isPointer(v) {
+		return ErrNotPointer
+	}
+
+	b, err := c.store.Get(key)
+	if err != nil {
+		return err
+	}
+	return c.formatter.Unmarshal(b, v)
+}
+
+func (c *serializingCache) Delete(key string) error {
+	return c.store.Delete(key)
+}
+
+type serializingDistributedCache struct {
+	store     DistributedByteStore
+	formatter datas.ByteFormatter
+}
+
+// NewSerializingDistributed returns a DistributedCache which encodes values with formatter before storing them
+// in DistributedByteStore and decodes them on Get.
+func NewSerializingDistributed(store DistributedByteStore, formatter datas.ByteFormatter) DistributedCache {
+	return &serializingDistributedCache{
+		store:     store,
+		formatter: formatter,
+	}
+}
+
+func (c *serializingDistributedCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	b, err := c.formatter.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return c.store.Set(ctx, key, b, ttl)
+}
+
+func (c *serializingDistributedCache) Get(ctx context.Context, key string, v interface{}) error {
+	if !isPointer(v) {
+		return ErrNotPointer
+	}
+
+	b, err := c.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	return c.formatter.Unmarshal(b, v)
+}
+
+func (c *serializingDistributedCache) Delete(ctx context.Context, key string) error {
+	return c.store.Delete(ctx, key)
+}
+
+// isPointer reports whether v is non-nil pointer.
+func isPointer(v interface{}) bool {
+	if v == nil {
+		return false
+	}
+	val := reflect.ValueOf(v)
+	return val.Kind() == reflect.Pointer && !val.IsNil()
+}
@@ -0,0 +1,157 @@
+package caches_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/caches"
+	"github.com/Prastiwar/Go-flow/caches/cachetest"
+	"github.com/Prastiwar/Go-flow/datas"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+	"github.com/Prastiwar/Go-flow/tests/mocks"
+)
+
+type serializedData struct {
+	Foo string `json:"foo" xml:"foo"`
+}
+
+type storedBytes struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// newByteStore returns mocks.ByteStoreMock backed by map with ttl support.
+func newByteStore() mocks.ByteStoreMock {
+	var mu sync.Mutex
+	store := map[string]storedBytes{}
+	lookup := func(key string) (storedBytes, bool) {
+		b, ok := store[key]
+		if ok && !b.expiresAt.IsZero() && !time.Now().Before(b.expiresAt) {
+			delete(store, key)
+			return b, false
+		}
+		return b, ok
+	}
+	return mocks.ByteStoreMock{
+		OnGet: func(key string) ([]byte, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			b, ok := lookup(key)
+			if !ok {
+				return nil, caches.ErrNotFound
+			}
+			return b.value, nil
+		},
+		OnSet: func(key string, value []byte, ttl time.Duration) error {
+			mu.Lock()
+			defer mu.Unlock()
+			b := storedBytes{value: value}
+			if ttl != caches.NoExpiration {
+				b.expiresAt = time.Now().Add(ttl)
+			}
+			store[key] = b
+			return nil
+		},
+		OnDelete: func(key string) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if _, ok := lookup(key); !ok {
+				return caches.ErrNotFound
+			}
+			delete(store, key)
+			return nil
+		},
+	}
+}
+
+func TestSerializingConformance(t *testing.T) {
+	cachetest.TestCache(t, func() caches.Cache {
+		return caches.NewSerializing(newByteStore(), datas.Json())
+	})
+}
+
+func TestSerializing(t *testing.T) {
+	formatters := map[string]datas.ByteFormatter{
+		"json": datas.Json(),
+		"xml":  datas.Xml(),
+	}
+
+	for name, formatter := range formatters {
+		t.Run(name, func(t *testing.T) {
+			store := newByteStore()
+			cache := caches.NewSerializing(store, formatter)
+
+			err := cache.Set("key", serializedData{Foo: "bar"}, caches.NoExpiration)
+			assert.NilError(t, err)
+
+			raw, err := store.Get("key")
+			assert.NilError(t, err)
+			assert.NotEqual(t, 0, len(raw))
+
+			var actual serializedData
+			assert.NilError(t, cache.Get("key", &actual))
+			assert.Equal(t, "bar", actual.Foo)
+
+			assert.ErrorIs(t, cache.Get("key", actual), caches.ErrNotPointer)
+			assert.NilError(t, cache.Delete("key"))
+			assert.ErrorIs(t, cache.Get("key", &actual), caches.ErrNotFound)
+			assert.ErrorIs(t, cache.Delete("key"), caches.ErrNotFound)
+		})
+	}
+}
+
+func TestSerializingErrors(t *testing.T) {
+	formatErr := errors.New("format failure")
+	formatter := mocks.ByteIOFormatterMock{
+		OnMarshal: func(v any) ([]byte, error) {
+			return nil, formatErr
+		},
+		OnUnmarshal: func(data []byte, v any) error {
+			return formatErr
+		},
+	}
+	store := newByteStore()
+	_ = store.Set("key", []byte("{}"), caches.NoExpiration)
+
+	cache := caches.NewSerializing(store, formatter)
+
+	assert.ErrorIs(t, cache.Set("key", 1, caches.NoExpiration), formatErr, "marshal")
+
+	var actual int
+	assert.ErrorIs(t, cache.Get("key", &actual), formatErr, "unmarshal")
+}
+
+func TestSerializingDistributed(t *testing.T) {
+	ctx := context.Background()
+	store := newByteStore()
+	cache := caches.NewSerializingDistributed(mocks.DistributedByteStoreMock{
+		OnGet: func(ctx context.Context, key string) ([]byte, error) {
+			return store.Get(key)
+		},
+		OnSet: func(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+			assert.Equal(t, time.Minute, ttl)
+			return store.Set(key, value, ttl)
+		},
+		OnDelete: func(ctx context.Context, key string) error {
+			return store.Delete(key)
+		},
+	}, datas.Json())
+
+	err := cache.Set(ctx, "key", serializedData{Foo: "bar"}, time.Minute)
+	assert.NilError(t, err)
+
+	raw, err := store.Get("key")
+	assert.NilError(t, err)
+	assert.Equal(t, `{"foo":"bar"}`, string(raw))
+
+	var actual serializedData
+	assert.NilError(t, cache.Get(ctx, "key", &actual))
+	assert.Equal(t, "bar", actual.Foo)
+
+	assert.ErrorIs(t, cache.Get(ctx, "key", nil), caches.ErrNotPointer)
+	assert.NilError(t, cache.Delete(ctx, "key"))
+	assert.ErrorIs(t, cache.Get(ctx, "key", &actual), caches.ErrNotFound)
+}
@@ -0,0 +1,137 @@
+package caches
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	_ Cache            = (*InstrumentedCache)(nil)
+	_ DistributedCache = (*InstrumentedDistributedCache)(nil)
+)
+
+// Stats is a snapshot of cache usage counters. Misses count Get calls which returned ErrNotFound.
+// Errors count any other error returned by cache, Delete returning ErrNotFound is not counted at all.
+type Stats struct {
+	Hits    uint64
+	Misses  uint64
+	Sets    uint64
+	Deletes uint64
+	Errors  uint64
+}
+
+// HitRatio returns ratio of hits to all successful lookups. It returns 0 if there was no lookup.
+func (s Stats) HitRatio() float64 {
+	lookups := s.Hits + s.Misses
+	if lookups == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(lookups)
+}
+
+type counters struct {
+	hits    atomic.Uint64
+	misses  atomic.Uint64
+	sets    atomic.Uint64
+	deletes atomic.Uint64
+	errors  atomic.Uint64
+}
+
+func (c *counters) get(err error) error {
+	switch {
+	case err == nil:
+		c.hits.Add(1)
+	case errors.Is(err, ErrNotFound):
+		c.misses.Add(1)
+	default:
+		c.errors.Add(1)
+	}
+	return err
+}
+
+func (c *counters) set(err error) error {
+	if err != nil {
+		c.errors.Add(1)
+		return err
+	}
+	c.sets.Add(1)
+	return nil
+}
+
+func (c *counters) delete(err error) error {
+	switch {
+	case err == nil:
+		c.deletes.Add(1)
+	case !errors.Is(err, ErrNotFound):
+		c.errors.Add(1)
+	}
+	return err
+}
+
+func (c *counters) snapshot() Stats {
+	return Stats{
+		Hits:    c.hits.Load(),
+		Misses:  c.misses.Load(),
+		Sets:    c.sets.Load(),
+		Deletes: c.deletes.Load(),
+		Errors:  c.errors.Load(),
+	}
+}
+
+// InstrumentedCache is a Cache decorator which counts hits, misses, sets, deletes and errors.
+type InstrumentedCache struct {
+	cache    Cache
+	counters counters
+}
+
+// NewInstrumented returns InstrumentedCache decorating provided Cache.
+func NewInstrumented(cache Cache) *InstrumentedCache {
+	return &InstrumentedCache{cache: cache}
+}
+
+func (c *InstrumentedCache) Set(key string, value interface{}, ttl time.Duration) error {
+	return c.counters.set(c.cache.Set(key, value, ttl))
+}
+
+func (c *InstrumentedCache) Get(key string, v interface{}) error {
+	return c.counters.get(c.cache.Get(key, v))
+}
+
+func (c *InstrumentedCache) Delete(key string) error {
+	return c.counters.delete(c.cache.Delete(key))
+}
+
+// Stats returns snapshot of current counters.
+func (c *InstrumentedCache) Stats() Stats {
+	return c.counters.snapshot()
+}
+
+// InstrumentedDistributedCache is a DistributedCache decorator which counts hits, misses, sets, deletes and errors.
+type InstrumentedDistributedCache struct {
+	cache    DistributedCache
+	counters counters
+}
+
+// NewInstrumentedDistributed returns InstrumentedDistributedCache decorating provided DistributedCache.
+func NewInstrumentedDistributed(cache DistributedCache) *InstrumentedDistributedCache {
+	return &InstrumentedDistributedCache{cache: cache}
+}
+
+func (c *InstrumentedDistributedCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	return c.counters.set(c.cache.Set(ctx, key, value, ttl))
+}
+
+func (c *InstrumentedDistributedCache) Get(ctx context.Context, key string, v interface{}) error {
+	return c.counters.get(c.cache.Get(ctx, key, v))
+}
+
+func (c *InstrumentedDistributedCache) Delete(ctx context.Context, key string) error {
+	return c.counters.delete(c.cache.Delete(ctx, key))
+}
+
+// Stats returns snapshot of current counters.
+func (c *InstrumentedDistributedCache) Stats() Stats {
+	return c.counters.snapshot()
+}
@@ -0,0 +1,63 @@
+package caches_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/caches"
+	"github.com/Prastiwar/Go-flow/caches/cachetest"
+	"github.com/Prastiwar/Go-flow/caches/memory"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+	"github.com/Prastiwar/Go-flow/tests/mocks"
+)
+
+func TestInstrumentedConformance(t *testing.T) {
+	cachetest.TestCache(t, func() caches.Cache {
+		return caches.NewInstrumented(memory.NewCache(context.Background(), 0))
+	})
+}
+
+func TestInstrumented(t *testing.T) {
+	cache := caches.NewInstrumented(memory.NewCache(context.Background(), 0))
+
+	var actual int
+	_ = cache.Get("key", &actual)
+	_ = cache.Set("key", 1, caches.NoExpiration)
+	_ = cache.Get("key", &actual)
+	_ = cache.Get("key", &actual)
+	_ = cache.Get("key", actual)
+	_ = cache.Delete("key")
+	_ = cache.Delete("key")
+
+	stats := cache.Stats()
+	assert.Equal(t, caches.Stats{Hits: 2, Misses: 1, Sets: 1, Deletes: 1, Errors: 1}, stats)
+	assert.Equal(t, 2.0/3.0, stats.HitRatio())
+}
+
+func TestInstrumentedDistributed(t *testing.T) {
+	cacheErr := errors.New("connection refused")
+	cache := caches.NewInstrumentedDistributed(mocks.DistributedCacheMock{
+		OnGet: func(ctx context.Context, key string, v interface{}) error {
+			return caches.ErrNotFound
+		},
+		OnSet: func(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+			return cacheErr
+		},
+		OnDelete: func(ctx context.Context, key string) error {
+			return nil
+		},
+	})
+	ctx := context.Background()
+
+	var actual int
+	assert.ErrorIs(t, cache.Get(ctx, "key", &actual), caches.ErrNotFound)
+	assert.ErrorIs(t, cache.Set(ctx, "key", 1, caches.NoExpiration), cacheErr)
+	assert.NilError(t, cache.Delete(ctx, "key"))
+
+	stats := cache.Stats()
+	assert.Equal(t, caches.Stats{Misses: 1, Deletes: 1, Errors: 1}, stats)
+	assert.Equal(t, 0.0, stats.HitRatio())
+	assert.Equal(t, 0.0, caches.Stats{}.HitRatio())
+}
@@ -0,0 +1,181 @@
+package caches
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	_ TaggedCache = (*taggedCache)(nil)
+)
+
+// TaggedCache is an optional capability interface for Cache which allows to invalidate
+// groups of entries at once either by tags attached on set or by key prefix.
+type TaggedCache interface {
+	Cache
+
+	// SetWithTags should store value as Set does and attach tags to the entry. Replacing the value
+	// replaces its tags as well.
+	SetWithTags(key string, value interface{}, ttl time.Duration, tags ...string) error
+
+	// InvalidateTag should erase every entry with attached tag. No error should be returned if there is no such entry.
+	InvalidateTag(tag string) error
+
+	// DeletePrefix should erase every entry which key starts with prefix. No error should be returned if there is no such entry.
+	DeletePrefix(prefix string) error
+}
+
+// taggedEntry is indexed entry with its tags and expiration time which is zero if entry never expires.
+type taggedEntry struct {
+	tags      []string
+	expiresAt time.Time
+}
+
+type taggedCache struct {
+	cache Cache
+
+	mu         sync.Mutex
+	keyTags    map[string]taggedEntry
+	tagKeys    map[string]map[string]struct{}
+	nextExpiry time.Time
+}
+
+// NewTagged returns TaggedCache decorating provided Cache. It keeps its own in-memory index of keys and tags,
+// so only entries set through returned TaggedCache can be invalidated. Index remembers ttl of each entry and expired
+// keys are removed from it lazily on the next SetWithTags, InvalidateTag or DeletePrefix call.
+func NewTagged(cache Cache) TaggedCache {
+	return &taggedCache{
+		cache:   cache,
+		keyTags: make(map[string]taggedEntry),
+		tagKeys: make(map[string]map[string]struct{}),
+	}
+}
+
+func (c *taggedCache) Set(key string, value interface{}, ttl time.Duration) error {
+	return c.SetWithTags(key, value, ttl)
+}
+
+func (c *taggedCache) SetWithTags(key string, value interface{}, ttl time.Duration, tags ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.cache.Set(key, value, ttl); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	c.prune(now)
+	c.unindex(key)
+
+	e := taggedEntry{tags: tags}
+	if ttl != NoExpiration {
+		e.expiresAt = now.Add(ttl)
+		if c.nextExpiry.IsZero() || e.expiresAt.Before(c.nextExpiry) {
+			c.nextExpiry = e.expiresAt
+		}
+	}
+
+	c.keyTags[key] = e
+	for _, tag := range tags {
+		keys, ok := c.tagKeys[tag]
+		if !ok {
+			keys = make(map[string]struct{})
+			c.tagKeys[tag] = keys
+		}
+		keys[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// Get does not change the index, so it cannot drop tags of entry set concurrently after the miss.
+func (c *taggedCache) Get(key string, v interface{}) error {
+	return c.cache.Get(key, v)
+}
+
+func (c *taggedCache) Delete(key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.delete(key)
+}
+
+func (c *taggedCache) InvalidateTag(tag string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.prune(time.Now())
+
+	var errs error
+	for key := range c.tagKeys[tag] {
+		if err := c.delete(key); err != nil && !errors.Is(err, ErrNotFound) {
+			errs = errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
+func (c *taggedCache) DeletePrefix(prefix string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.prune(time.Now())
+
+	var errs error
+	for key := range c.keyTags {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if err := c.delete(key); err != nil && !errors.Is(err, ErrNotFound) {
+			errs = errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
+// delete erases key from underlying Cache and index. It must be called with acquired lock.
+func (c *taggedCache) delete(key string) error {
+	err := c.cache.Delete(key)
+	if err == nil || errors.Is(err, ErrNotFound) {
+		c.unindex(key)
+	}
+	return err
+}
+
+// prune removes expired keys from index if the earliest known expiration has passed, so index is scanned only
+// when there is something to remove. It must be called with acquired lock.
+func (c *taggedCache) prune(now time.Time) {
+	if c.nextExpiry.IsZero() || now.Before(c.nextExpiry) {
+		return
+	}
+
+	c.nextExpiry = time.Time{}
+	for key, e := range c.keyTags {
+		if e.expiresAt.IsZero() {
+			continue
+		}
+
+		if !now.Before(e.expiresAt) {
+			c.unindex(key)
+			continue
+		}
+
+		if c.nextExpiry.IsZero() || e.expiresAt.Before(c.nextExpiry) {
+			c.nextExpiry = e.expiresAt
+		}
+	}
+}
+
+// unindex removes key with its tags from index. It must be called with acquired lock.
+func (c *taggedCache) unindex(key string) {
+	for _, tag := range c.keyTags[key].tags {
+		keys := c.tagKeys[tag]
+		delete(keys, key)
+		if len(keys) == 0 {
+			delete(c.tagKeys, tag)
+		}
+	}
+	delete(c.keyTags, key)
+}
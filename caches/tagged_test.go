@@ -0,0 +1,138 @@
+package caches_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/caches"
+	"github.com/Prastiwar/Go-flow/caches/cachetest"
+	"github.com/Prastiwar/Go-flow/caches/memory"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+	"github.com/Prastiwar/Go-flow/tests/mocks"
+)
+
+func TestTaggedConformance(t *testing.T) {
+	cachetest.TestCache(t, func() caches.Cache {
+		return caches.NewTagged(memory.NewCache(context.Background(), 0))
+	})
+}
+
+func TestTaggedInvalidateTag(t *testing.T) {
+	cache := caches.NewTagged(memory.NewCache(context.Background(), 0))
+
+	assert.NilError(t, cache.SetWithTags("user:1:profile", 1, caches.NoExpiration, "user:1"))
+	assert.NilError(t, cache.SetWithTags("user:1:orders", 2, caches.NoExpiration, "user:1", "orders"))
+	assert.NilError(t, cache.SetWithTags("user:2:orders", 3, caches.NoExpiration, "user:2", "orders"))
+
+	assert.NilError(t, cache.InvalidateTag("user:1"))
+
+	var actual int
+	assert.ErrorIs(t, cache.Get("user:1:profile", &actual), caches.ErrNotFound)
+	assert.ErrorIs(t, cache.Get("user:1:orders", &actual), caches.ErrNotFound)
+	assert.NilError(t, cache.Get("user:2:orders", &actual))
+
+	assert.NilError(t, cache.InvalidateTag("orders"))
+	assert.ErrorIs(t, cache.Get("user:2:orders", &actual), caches.ErrNotFound)
+
+	assert.NilError(t, cache.InvalidateTag("missing"))
+}
+
+func TestTaggedReplaceTags(t *testing.T) {
+	cache := caches.NewTagged(memory.NewCache(context.Background(), 0))
+
+	assert.NilError(t, cache.SetWithTags("key", 1, caches.NoExpiration, "old"))
+	assert.NilError(t, cache.SetWithTags("key", 2, caches.NoExpiration, "new"))
+
+	assert.NilError(t, cache.InvalidateTag("old"))
+
+	var actual int
+	assert.NilError(t, cache.Get("key", &actual))
+	assert.Equal(t, 2, actual)
+
+	assert.NilError(t, cache.InvalidateTag("new"))
+	assert.ErrorIs(t, cache.Get("key", &actual), caches.ErrNotFound)
+}
+
+func TestTaggedGetMissKeepsConcurrentTags(t *testing.T) {
+	store := memory.NewCache(context.Background(), 0)
+	var cache caches.TaggedCache
+	cache = caches.NewTagged(mocks.CacheMock{
+		OnGet: func(key string, v interface{}) error {
+			err := store.Get(key, v)
+			// entry is set with tags after the miss but before Get returns
+			assert.NilError(t, cache.SetWithTags(key, 1, caches.NoExpiration, "tag"))
+			return err
+		},
+		OnSet: func(key string, value interface{}, ttl time.Duration) error {
+			return store.Set(key, value, ttl)
+		},
+		OnDelete: func(key string) error {
+			return store.Delete(key)
+		},
+	})
+
+	var actual int
+	assert.ErrorIs(t, cache.Get("key", &actual), caches.ErrNotFound)
+
+	assert.NilError(t, cache.InvalidateTag("tag"))
+	assert.ErrorIs(t, store.Get("key", &actual), caches.ErrNotFound)
+}
+
+func TestTaggedDeletePrefix(t *testing.T) {
+	cache := caches.NewTagged(memory.NewCache(context.Background(), 0))
+
+	assert.NilError(t, cache.Set("tenant:1:a", 1, caches.NoExpiration))
+	assert.NilError(t, cache.Set("tenant:1:b", 2, 10*time.Millisecond))
+	assert.NilError(t, cache.Set("tenant:2:a", 3, caches.NoExpiration))
+
+	time.Sleep(20 * time.Millisecond)
+
+	assert.NilError(t, cache.DeletePrefix("tenant:1:"))
+
+	var actual int
+	assert.ErrorIs(t, cache.Get("tenant:1:a", &actual), caches.ErrNotFound)
+	assert.ErrorIs(t, cache.Get("tenant:1:b", &actual), caches.ErrNotFound)
+	assert.NilError(t, cache.Get("tenant:2:a", &actual))
+	assert.Equal(t, 3, actual)
+}
+
+func TestTaggedPrunesExpiredKeys(t *testing.T) {
+	deleteCounter := assert.Count(t, 1)
+	cache := caches.NewTagged(mocks.CacheMock{
+		OnSet: func(key string, value interface{}, ttl time.Duration) error {
+			return nil
+		},
+		OnDelete: func(key string) error {
+			deleteCounter.Inc()
+			assert.Equal(t, "live", key)
+			return nil
+		},
+	})
+
+	assert.NilError(t, cache.SetWithTags("expired", 1, 10*time.Millisecond, "tag"))
+	assert.NilError(t, cache.SetWithTags("live", 2, time.Hour, "tag"))
+	time.Sleep(20 * time.Millisecond)
+
+	assert.NilError(t, cache.InvalidateTag("tag"))
+	deleteCounter.Assert(t)
+}
+
+func TestTaggedErrors(t *testing.T) {
+	deleteErr := errors.New("delete failure")
+	cache := caches.NewTagged(mocks.CacheMock{
+		OnSet: func(key string, value interface{}, ttl time.Duration) error {
+			return nil
+		},
+		OnDelete: func(key string) error {
+			return deleteErr
+		},
+	})
+
+	assert.NilError(t, cache.SetWithTags("key", 1, caches.NoExpiration, "tag"))
+
+	assert.ErrorIs(t, cache.InvalidateTag("tag"), deleteErr, "invalidate tag")
+	assert.ErrorIs(t, cache.DeletePrefix("k"), deleteErr, "delete prefix")
+	assert.ErrorIs(t, cache.Delete("key"), deleteErr, "delete")
+}
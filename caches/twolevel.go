@@ -0,0 +1,143 @@
+package caches
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"time"
+)
+
+var (
+	_ DistributedCache = (*TwoLevelCache)(nil)
+)
+
+// InvalidateFunc is a function called after key was changed or deleted in TwoLevelCache.
+// It's meant to publish invalidation message to other nodes, so they can call TwoLevelCache.Invalidate
+// to evict their local entries.
+type InvalidateFunc func(ctx context.Context, key string) error
+
+// TwoLevelOptions stores settings to control behaviour of TwoLevelCache.
+type TwoLevelOptions struct {
+	LocalTTL   time.Duration
+	Invalidate InvalidateFunc
+}
+
+// TwoLevelOption is function which mutates the TwoLevelOptions specific field.
+type TwoLevelOption func(*TwoLevelOptions)
+
+// NewTwoLevelOptions returns a new TwoLevelOptions.
+func NewTwoLevelOptions(opts ...TwoLevelOption) *TwoLevelOptions {
+	o := &TwoLevelOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithLocalTTL returns a new TwoLevelOption to configure ttl used for entries stored in local tier.
+// Local entry never outlives the ttl passed to Set. NoExpiration means the ttl passed to Set is used.
+// Values read from L2 are stored in L1 only if local ttl is positive, since ttl of L2 entry is unknown.
+func WithLocalTTL(ttl time.Duration) TwoLevelOption {
+	return func(o *TwoLevelOptions) {
+		o.LocalTTL = ttl
+	}
+}
+
+// WithInvalidation returns a new TwoLevelOption to configure InvalidateFunc called after Set and Delete.
+func WithInvalidation(fn InvalidateFunc) TwoLevelOption {
+	return func(o *TwoLevelOptions) {
+		o.Invalidate = fn
+	}
+}
+
+// TwoLevelCache is a DistributedCache which reads through local Cache (L1) before DistributedCache (L2).
+// Writes and deletes reach both tiers. L2 is the source of truth, so any error from L1 other than
+// ErrNotPointer is treated as a miss.
+type TwoLevelCache struct {
+	local   Cache
+	remote  DistributedCache
+	options *TwoLevelOptions
+}
+
+// NewTwoLevel returns a new TwoLevelCache using local as L1 and remote as L2 tier.
+func NewTwoLevel(local Cache, remote DistributedCache, opts ...TwoLevelOption) *TwoLevelCache {
+	return &TwoLevelCache{
+		local:   local,
+		remote:  remote,
+		options: NewTwoLevelOptions(opts...),
+	}
+}
+
+// Set stores value in L2 with ttl and then in L1 with local ttl. If InvalidateFunc is configured, it's called afterwards.
+func (c *TwoLevelCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	if err := c.remote.Set(ctx, key, value, ttl); err != nil {
+		return err
+	}
+
+	if err := c.local.Set(key, value, c.localTTL(ttl)); err != nil {
+		return err
+	}
+
+	return c.publish(ctx, key)
+}
+
+// Get assigns value from L1 to v. On L1 miss it reads the value from L2 and stores it in L1 with local ttl.
+// If local ttl is not configured, the value is not stored in L1, so it never outlives L2 entry.
+func (c *TwoLevelCache) Get(ctx context.Context, key string, v interface{}) error {
+	err := c.local.Get(key, v)
+	if err == nil || errors.Is(err, ErrNotPointer) {
+		return err
+	}
+
+	if err := c.remote.Get(ctx, key, v); err != nil {
+		return err
+	}
+
+	// ttl of L2 entry is unknown so only bounded local ttl can be used
+	if c.options.LocalTTL > 0 {
+		_ = c.local.Set(key, reflect.ValueOf(v).Elem().Interface(), c.options.LocalTTL)
+	}
+	return nil
+}
+
+// Delete erases value from both tiers. ErrNotFound is returned only if L2 did not contain the key.
+// If InvalidateFunc is configured, it's called afterwards.
+func (c *TwoLevelCache) Delete(ctx context.Context, key string) error {
+	if err := c.local.Delete(key); err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	if err := c.remote.Delete(ctx, key); err != nil {
+		return err
+	}
+
+	return c.publish(ctx, key)
+}
+
+// Invalidate evicts key from L1 only. It should be called when invalidation message from other node is received.
+func (c *TwoLevelCache) Invalidate(key string) error {
+	err := c.local.Delete(key)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (c *TwoLevelCache) publish(ctx context.Context, key string) error {
+	if c.options.Invalidate == nil {
+		return nil
+	}
+	return c.options.Invalidate(ctx, key)
+}
+
+// localTTL returns ttl for L1 entry which cannot outlive L2 entry with ttl.
+func (c *TwoLevelCache) localTTL(ttl time.Duration) time.Duration {
+	localTTL := c.options.LocalTTL
+	if localTTL == NoExpiration {
+		return ttl
+	}
+	if ttl != NoExpiration && ttl < localTTL {
+		return ttl
+	}
+	return localTTL
+}
@@ -0,0 +1,155 @@
+package caches_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/caches"
+	"github.com/Prastiwar/Go-flow/caches/cachetest"
+	"github.com/Prastiwar/Go-flow/caches/memory"
+	"github.com/Prastiwar/Go-flow/datas"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+	"github.com/Prastiwar/Go-flow/tests/mocks"
+)
+
+// newRemote returns mocks.DistributedCacheMock backed by in-memory cache together with counter of Get calls.
+func newRemote() (mocks.DistributedCacheMock, *int) {
+	store := memory.NewCache(context.Background(), 0)
+	gets := 0
+	return mocks.DistributedCacheMock{
+		OnGet: func(ctx context.Context, key string, v interface{}) error {
+			gets++
+			return store.Get(key, v)
+		},
+		OnSet: func(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+			return store.Set(key, value, ttl)
+		},
+		OnDelete: func(ctx context.Context, key string) error {
+			return store.Delete(key)
+		},
+	}, &gets
+}
+
+func TestTwoLevelConformance(t *testing.T) {
+	cachetest.TestDistributedCache(t, func() caches.DistributedCache {
+		store := newByteStore()
+		remote := caches.NewSerializingDistributed(mocks.DistributedByteStoreMock{
+			OnGet: func(ctx context.Context, key string) ([]byte, error) {
+				return store.Get(key)
+			},
+			OnSet: func(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+				return store.Set(key, value, ttl)
+			},
+			OnDelete: func(ctx context.Context, key string) error {
+				return store.Delete(key)
+			},
+		}, datas.Json())
+		return caches.NewTwoLevel(memory.NewCache(context.Background(), 0), remote)
+	})
+}
+
+func TestTwoLevelGet(t *testing.T) {
+	ctx := context.Background()
+	local := memory.NewCache(ctx, 0)
+	remote, remoteGets := newRemote()
+	assert.NilError(t, remote.Set(ctx, "key", "foo", caches.NoExpiration))
+
+	cache := caches.NewTwoLevel(local, remote, caches.WithLocalTTL(time.Hour))
+
+	var actual string
+	assert.NilError(t, cache.Get(ctx, "key", &actual), "read-through")
+	assert.Equal(t, "foo", actual)
+
+	actual = ""
+	assert.NilError(t, cache.Get(ctx, "key", &actual), "local hit")
+	assert.Equal(t, "foo", actual)
+	assert.Equal(t, 1, *remoteGets)
+
+	err := cache.Get(ctx, "missing", &actual)
+	assert.ErrorIs(t, err, caches.ErrNotFound)
+
+	err = cache.Get(ctx, "key", actual)
+	assert.ErrorIs(t, err, caches.ErrNotPointer)
+}
+
+func TestTwoLevelSetDelete(t *testing.T) {
+	ctx := context.Background()
+	local := memory.NewCache(ctx, 0)
+	remote, _ := newRemote()
+	invalidateCounter := assert.Count(t, 2)
+
+	cache := caches.NewTwoLevel(local, remote,
+		caches.WithLocalTTL(10*time.Millisecond),
+		caches.WithInvalidation(func(ctx context.Context, key string) error {
+			invalidateCounter.Inc()
+			assert.Equal(t, "key", key)
+			return nil
+		}),
+	)
+
+	assert.NilError(t, cache.Set(ctx, "key", "foo", caches.NoExpiration))
+
+	var actual string
+	assert.NilError(t, local.Get("key", &actual), "local tier")
+	assert.NilError(t, remote.Get(ctx, "key", &actual), "remote tier")
+
+	time.Sleep(20 * time.Millisecond)
+	assert.ErrorIs(t, local.Get("key", &actual), caches.ErrNotFound, "local ttl")
+	assert.NilError(t, remote.Get(ctx, "key", &actual), "remote without ttl")
+
+	assert.NilError(t, cache.Delete(ctx, "key"))
+	assert.ErrorIs(t, remote.Get(ctx, "key", &actual), caches.ErrNotFound, "remote deleted")
+	assert.ErrorIs(t, cache.Delete(ctx, "key"), caches.ErrNotFound, "delete missing")
+}
+
+func TestTwoLevelLocalTTLCappedBySetTTL(t *testing.T) {
+	ctx := context.Background()
+	local := memory.NewCache(ctx, 0)
+	remote, _ := newRemote()
+
+	cache := caches.NewTwoLevel(local, remote, caches.WithLocalTTL(time.Hour))
+
+	assert.NilError(t, cache.Set(ctx, "key", "foo", 10*time.Millisecond))
+	time.Sleep(20 * time.Millisecond)
+
+	var actual string
+	assert.ErrorIs(t, local.Get("key", &actual), caches.ErrNotFound)
+}
+
+func TestTwoLevelGetRemoteExpiration(t *testing.T) {
+	ctx := context.Background()
+	local := memory.NewCache(ctx, 0)
+	remote, remoteGets := newRemote()
+	assert.NilError(t, remote.Set(ctx, "key", "foo", 10*time.Millisecond))
+
+	cache := caches.NewTwoLevel(local, remote)
+
+	var actual string
+	assert.NilError(t, cache.Get(ctx, "key", &actual))
+	assert.Equal(t, "foo", actual)
+	assert.ErrorIs(t, local.Get("key", &actual), caches.ErrNotFound, "not stored without local ttl")
+
+	time.Sleep(20 * time.Millisecond)
+
+	assert.ErrorIs(t, cache.Get(ctx, "key", &actual), caches.ErrNotFound, "expired in remote")
+	assert.Equal(t, 2, *remoteGets)
+}
+
+func TestTwoLevelInvalidate(t *testing.T) {
+	ctx := context.Background()
+	local := memory.NewCache(ctx, 0)
+	remote, remoteGets := newRemote()
+
+	cache := caches.NewTwoLevel(local, remote)
+	assert.NilError(t, cache.Set(ctx, "key", "foo", caches.NoExpiration))
+
+	assert.NilError(t, cache.Invalidate("key"))
+	assert.NilError(t, cache.Invalidate("key"), "invalidate missing")
+
+	var actual string
+	assert.ErrorIs(t, local.Get("key", &actual), caches.ErrNotFound)
+	assert.NilError(t, cache.Get(ctx, "key", &actual))
+	assert.Equal(t, "foo", actual)
+	assert.Equal(t, 1, *remoteGets)
+}
@@ -0,0 +1,70 @@
+package caches
+
+import (
+	"context"
+	"time"
+)
+
+// Typed is a generic facade over Cache which stores and returns values of T type.
+// Errors returned by underlying Cache are passed through unchanged, so sentinel errors
+// like ErrNotFound can still be checked with errors.Is.
+type Typed[T any] struct {
+	cache Cache
+}
+
+// NewTyped returns Typed facade over provided Cache.
+func NewTyped[T any](cache Cache) *Typed[T] {
+	return &Typed[T]{cache: cache}
+}
+
+// Set stores value with ttl in underlying Cache.
+func (c *Typed[T]) Set(key string, value T, ttl time.Duration) error {
+	return c.cache.Set(key, value, ttl)
+}
+
+// Get returns value stored with key. If error occurred it's returned together with zero value of T.
+func (c *Typed[T]) Get(key string) (T, error) {
+	var v T
+	if err := c.cache.Get(key, &v); err != nil {
+		var zero T
+		return zero, err
+	}
+	return v, nil
+}
+
+// Delete erases value stored with key in underlying Cache.
+func (c *Typed[T]) Delete(key string) error {
+	return c.cache.Delete(key)
+}
+
+// TypedDistributed is a generic facade over DistributedCache which stores and returns values of T type.
+// Errors returned by underlying DistributedCache are passed through unchanged, so sentinel errors
+// like ErrNotFound can still be checked with errors.Is.
+type TypedDistributed[T any] struct {
+	cache DistributedCache
+}
+
+// NewTypedDistributed returns TypedDistributed facade over provided DistributedCache.
+func NewTypedDistributed[T any](cache DistributedCache) *TypedDistributed[T] {
+	return &TypedDistributed[T]{cache: cache}
+}
+
+// Set stores value with ttl in underlying DistributedCache.
+func (c *TypedDistributed[T]) Set(ctx context.Context, key string, value T, ttl time.Duration) error {
+	return c.cache.Set(ctx, key, value, ttl)
+}
+
+// Get returns value stored with key. If error occurred it's returned together with zero value of T.
+func (c *TypedDistributed[T]) Get(ctx context.Context, key string) (T, error) {
+	var v T
+	if err := c.cache.Get(ctx, key, &v); err != nil {
+		var zero T
+		return zero, err
+	}
+	return v, nil
+}
+
+// Delete erases value stored with key in underlying DistributedCache.
+func (c *TypedDistributed[T]) Delete(ctx context.Context, key string) error {
+	return c.cache.Delete(ctx, key)
+}
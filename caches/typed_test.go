@@ -0,0 +1,116 @@
+package caches_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/caches"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+	"github.com/Prastiwar/Go-flow/tests/mocks"
+)
+
+func TestTyped(t *testing.T) {
+	t.Run("success-get", func(t *testing.T) {
+		cache := caches.NewTyped[int](mocks.CacheMock{
+			OnGet: func(key string, v interface{}) error {
+				assert.Equal(t, "key", key)
+				*(v.(*int)) = 5
+				return nil
+			},
+		})
+
+		actual, err := cache.Get("key")
+
+		assert.NilError(t, err)
+		assert.Equal(t, 5, actual)
+	})
+
+	t.Run("invalid-get", func(t *testing.T) {
+		cache := caches.NewTyped[int](mocks.CacheMock{
+			OnGet: func(key string, v interface{}) error {
+				*(v.(*int)) = 5
+				return caches.ErrNotFound
+			},
+		})
+
+		actual, err := cache.Get("key")
+
+		assert.ErrorIs(t, err, caches.ErrNotFound)
+		assert.Equal(t, 0, actual)
+	})
+
+	t.Run("success-set-delete", func(t *testing.T) {
+		setCounter := assert.Count(t, 1)
+		deleteCounter := assert.Count(t, 1)
+		cache := caches.NewTyped[string](mocks.CacheMock{
+			OnSet: func(key string, value interface{}, ttl time.Duration) error {
+				setCounter.Inc()
+				assert.Equal(t, "key", key)
+				assert.Equal(t, "foo", value)
+				assert.Equal(t, time.Second, ttl)
+				return nil
+			},
+			OnDelete: func(key string) error {
+				deleteCounter.Inc()
+				assert.Equal(t, "key", key)
+				return nil
+			},
+		})
+
+		assert.NilError(t, cache.Set("key", "foo", time.Second))
+		assert.NilError(t, cache.Delete("key"))
+	})
+}
+
+func TestTypedDistributed(t *testing.T) {
+	t.Run("success-get", func(t *testing.T) {
+		cache := caches.NewTypedDistributed[int](mocks.DistributedCacheMock{
+			OnGet: func(ctx context.Context, key string, v interface{}) error {
+				assert.Equal(t, "key", key)
+				*(v.(*int)) = 5
+				return nil
+			},
+		})
+
+		actual, err := cache.Get(context.Background(), "key")
+
+		assert.NilError(t, err)
+		assert.Equal(t, 5, actual)
+	})
+
+	t.Run("invalid-get", func(t *testing.T) {
+		cache := caches.NewTypedDistributed[int](mocks.DistributedCacheMock{
+			OnGet: func(ctx context.Context, key string, v interface{}) error {
+				return caches.ErrNotFound
+			},
+		})
+
+		actual, err := cache.Get(context.Background(), "key")
+
+		assert.ErrorIs(t, err, caches.ErrNotFound)
+		assert.Equal(t, 0, actual)
+	})
+
+	t.Run("success-set-delete", func(t *testing.T) {
+		setCounter := assert.Count(t, 1)
+		deleteCounter := assert.Count(t, 1)
+		cache := caches.NewTypedDistributed[string](mocks.DistributedCacheMock{
+			OnSet: func(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+				setCounter.Inc()
+				assert.Equal(t, "key", key)
+				assert.Equal(t, "foo", value)
+				assert.Equal(t, time.Second, ttl)
+				return nil
+			},
+			OnDelete: func(ctx context.Context, key string) error {
+				deleteCounter.Inc()
+				assert.Equal(t, "key", key)
+				return nil
+			},
+		})
+
+		assert.NilError(t, cache.Set(context.Background(), "key", "foo", time.Second))
+		assert.NilError(t, cache.Delete(context.Background(), "key"))
+	})
+}
@@ -1,6 +1,38 @@
 package config
 
 const (
-	EnvProviderName  = "env"
-	FlagProviderName = "flag"
+	EnvProviderName     = "env"
+	FlagProviderName    = "flag"
+	ReaderProviderName  = "reader"
+	SecretsProviderName = "secrets"
+	DotenvProviderName  = "dotenv"
+	RemoteProviderName  = "remote"
+)
+
+const (
+	// ConfigTag defines field key used by env, flag, dotenv and secrets providers unless provider specific tag
+	// is set. Reader, file, profile and remote providers decode documents with their decoder, so keys are
+	// defined by decoder tags (e.g. `json` or `yaml`) instead.
+	ConfigTag = "config"
+	// DefaultTag defines field default value applied by Source before any provider is loaded.
+	DefaultTag = "default"
+	// RequiredTag marks field which must be set by at least one provider when set to "true".
+	RequiredTag = "required"
+	// SecretTag marks field which value is redacted in LoadReport when set to "true".
+	SecretTag = "secret"
+	// ValidateTag defines comma-separated validation rules checked by Validate after Source is loaded.
+	ValidateTag = "validate"
+)
+
+// bindSetterName is name of FieldSetter used by Bind.
+const bindSetterName = "bind"
+
+// DefaultProviderName is reported in LoadReport for values set by `default` tag or Source.SetDefault.
+const DefaultProviderName = "default"
+
+const (
+	// DefaultKeySeparator is used to join nested field keys for providers without specific separator.
+	DefaultKeySeparator = "."
+	// EnvKeySeparator is used to join nested field keys for environment and secrets providers.
+	EnvKeySeparator = "_"
 )
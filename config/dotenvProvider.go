@@ -0,0 +1,229 @@
+package config
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+)
+
+type DotenvOption func(*DotenvOptions)
+
+// DotenvOptions stores settings to control behaviour of dotenv provider.
+type DotenvOptions struct {
+	Prefix string
+	Export bool
+}
+
+func NewDotenvOptions(options ...DotenvOption) *DotenvOptions {
+	opts := &DotenvOptions{}
+	for _, o := range options {
+		o(opts)
+	}
+
+	return opts
+}
+
+// WithDotenvPrefix sets prefix prepended to each key before it's looked up in the file,
+// the same way as prefix of NewEnvProviderWith.
+func WithDotenvPrefix(prefix string) DotenvOption {
+	return func(o *DotenvOptions) {
+		o.Prefix = prefix
+	}
+}
+
+// WithExport enables exporting parsed values into the process environment on each Load.
+// Variables which are already set in the process environment are not overridden.
+func WithExport() DotenvOption {
+	return func(o *DotenvOptions) {
+		o.Export = true
+	}
+}
+
+type dotenvProvider struct {
+	filename string
+	options  DotenvOptions
+}
+
+// NewDotenvProvider returns a new provider loading variables from .env file found at filename.
+// It binds fields with the same rules as environment provider - keys are intercepted with EnvProviderName,
+// can be specified with `env` tag and nested fields are joined with EnvKeySeparator.
+func NewDotenvProvider(filename string, opts ...DotenvOption) *dotenvProvider {
+	return &dotenvProvider{
+		filename: filename,
+		options:  *NewDotenvOptions(opts...),
+	}
+}
+
+// Load parses the file with ParseDotenv and stores value of each found variable in matching v field.
+// If there is no matching field it will be ignored and it's value will not be overridden.
+// Set fields are reported as set by DotenvProviderName provider.
+func (p *dotenvProvider) Load(ctx context.Context, v any, opts ...LoadOption) error {
+	f, err := os.Open(p.filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	values, err := ParseDotenv(f)
+	if err != nil {
+		return err
+	}
+
+	if p.options.Export {
+		for key, value := range values {
+			if _, ok := os.LookupEnv(key); ok {
+				continue
+			}
+			if err := os.Setenv(key, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	// keys are intercepted as for env provider, but set fields are reported as loaded from dotenv file
+	options := NewLoadOptions(opts...)
+	envOptions := *options
+	envOptions.FieldSetHook = func(providerName, path, key string) {
+		options.notifyFieldSet(DotenvProviderName, path, key)
+	}
+
+	setter := NewFieldSetter(EnvProviderName, envOptions)
+
+	return setter.SetFields(v, func(key string) (any, error) {
+		s, ok := values[p.options.Prefix+key]
+		if !ok {
+			return nil, nil
+		}
+
+		return s, nil
+	})
+}
+
+// ParseDotenv parses variables in .env syntax from r. Each line contains KEY=value pair which can be prefixed
+// with "export ". Empty lines and lines starting with # are ignored. Values can be:
+//   - unquoted - trimmed and ended by inline comment starting with " #",
+//   - single-quoted - taken literally and can span multiple lines,
+//   - double-quoted - can span multiple lines and support \n, \r, \t, \", \\ and \$ escape sequences.
+//
+// ${VAR} references in unquoted and double-quoted values are replaced with value of variable defined earlier
+// in the file or, if there is no such variable, with value of process environment variable.
+// It returns ErrInvalidDotenv wrapped with line number if the syntax is not valid.
+func ParseDotenv(r io.Reader) (map[string]string, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+	values := make(map[string]string)
+	lookup := func(key string) string {
+		if v, ok := values[key]; ok {
+			return v
+		}
+		return os.Getenv(key)
+	}
+
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimLeft(lines[i], " \t")
+		if strings.TrimSpace(line) == "" || line[0] == '#' {
+			continue
+		}
+
+		if rest, ok := strings.CutPrefix(line, "export"); ok && rest != "" && (rest[0] == ' ' || rest[0] == '\t') {
+			line = strings.TrimLeft(rest, " \t")
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" || strings.ContainsAny(key, " \t") {
+			return nil, wrapErrInvalidDotenv(lineNumber)
+		}
+
+		value = strings.TrimLeft(value, " \t")
+		if value == "" || (value[0] != '"' && value[0] != '\'') {
+			if idx := strings.Index(value, " #"); idx >= 0 {
+				value = value[:idx]
+			}
+			values[key] = expandDotenv(strings.TrimSpace(value), false, lookup)
+			continue
+		}
+
+		quote := value[0]
+		value = value[1:]
+		end := closingQuote(value, quote)
+		for end < 0 {
+			i++
+			if i >= len(lines) {
+				return nil, wrapErrInvalidDotenv(lineNumber)
+			}
+			value += "\n" + lines[i]
+			end = closingQuote(value, quote)
+		}
+
+		if trailing := strings.TrimSpace(value[end+1:]); trailing != "" && trailing[0] != '#' {
+			return nil, wrapErrInvalidDotenv(lineNumber)
+		}
+
+		value = value[:end]
+		if quote == '"' {
+			value = expandDotenv(value, true, lookup)
+		}
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+// closingQuote returns index of quote closing s or -1 if it's not found. Escaped quotes are skipped for double quote.
+func closingQuote(s string, quote byte) int {
+	for i := 0; i < len(s); i++ {
+		switch {
+		case quote == '"' && s[i] == '\\':
+			i++
+		case s[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
+
+// expandDotenv replaces ${VAR} references in s with value returned by lookup. If escapes is true,
+// escape sequences are replaced too and escaped \$ is not treated as reference.
+func expandDotenv(s string, escapes bool, lookup func(key string) string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if escapes && c == '\\' && i+1 < len(s) {
+			i++
+			switch s[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case '"', '\\', '$':
+				b.WriteByte(s[i])
+			default:
+				b.WriteByte('\\')
+				b.WriteByte(s[i])
+			}
+			continue
+		}
+
+		if c == '$' && i+1 < len(s) && s[i+1] == '{' {
+			if end := strings.IndexByte(s[i+2:], '}'); end >= 0 {
+				b.WriteString(lookup(s[i+2 : i+2+end]))
+				i += end + 2
+				continue
+			}
+		}
+
+		b.WriteByte(c)
+	}
+
+	return b.String()
+}
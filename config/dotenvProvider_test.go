@@ -0,0 +1,167 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Prastiwar/Go-flow/config"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+func TestParseDotenv(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    map[string]string
+		wantErr error
+	}{
+		{
+			name: "success-syntax",
+			content: strings.Join([]string{
+				"# comment",
+				"",
+				"PLAIN=value",
+				"  SPACED = spaced value  ",
+				"COMMENTED=value # comment",
+				"HASH=value#not-comment",
+				"export EXPORTED=exported",
+				"EMPTY=",
+				"SINGLE='${PLAIN} \\n literal'",
+				`DOUBLE="escaped \"quote\"\tand\nnewline"`,
+				"QUOTED_COMMENT='value' # comment",
+			}, "\n"),
+			want: map[string]string{
+				"PLAIN":          "value",
+				"SPACED":         "spaced value",
+				"COMMENTED":      "value",
+				"HASH":           "value#not-comment",
+				"EXPORTED":       "exported",
+				"EMPTY":          "",
+				"SINGLE":         "${PLAIN} \\n literal",
+				"DOUBLE":         "escaped \"quote\"\tand\nnewline",
+				"QUOTED_COMMENT": "value",
+			},
+		},
+		{
+			name:    "success-multiline",
+			content: "CERT=\"line1\nline2\"\r\nKEY='a\n# not comment\nb'\nNEXT=next",
+			want: map[string]string{
+				"CERT": "line1\nline2",
+				"KEY":  "a\n# not comment\nb",
+				"NEXT": "next",
+			},
+		},
+		{
+			name:    "success-expansion",
+			content: "HOST=localhost\nURL=http://${HOST}:${DOTENV_TEST_PORT}/${MISSING}\nRAW=\"\\${HOST}\"",
+			want: map[string]string{
+				"HOST": "localhost",
+				"URL":  "http://localhost:8080/",
+				"RAW":  "${HOST}",
+			},
+		},
+		{
+			name:    "invalid-missing-separator",
+			content: "VALID=1\nINVALID",
+			wantErr: config.ErrInvalidDotenv,
+		},
+		{
+			name:    "invalid-key",
+			content: "MY KEY=1",
+			wantErr: config.ErrInvalidDotenv,
+		},
+		{
+			name:    "invalid-unterminated-quote",
+			content: "KEY=\"value\nNEXT=1",
+			wantErr: config.ErrInvalidDotenv,
+		},
+		{
+			name:    "invalid-trailing-content",
+			content: "KEY='value' trailing",
+			wantErr: config.ErrInvalidDotenv,
+		},
+	}
+
+	t.Setenv("DOTENV_TEST_PORT", "8080")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := config.ParseDotenv(strings.NewReader(tt.content))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.MapMatch(t, tt.want, got)
+		})
+	}
+}
+
+func TestDotenvProviderLoad(t *testing.T) {
+	checkEnvironment(t)
+
+	filename := filepath.Join(t.TempDir(), ".env")
+	content := strings.Join([]string{
+		"APP_NAME=dotenv",
+		"APP_PORT=8080",
+		"APP_DATABASE_HOST=db",
+		"APP_TAGGED=tagged",
+		"DOTENV_TEST_EXPORTED=exported",
+		"DOTENV_TEST_EXISTING=from-file",
+	}, "\n")
+	assert.NilError(t, os.WriteFile(filename, []byte(content), 0o600))
+
+	t.Setenv("DOTENV_TEST_EXISTING", "from-env")
+	t.Setenv("DOTENV_TEST_EXPORTED", "")
+	assert.NilError(t, os.Unsetenv("DOTENV_TEST_EXPORTED"))
+
+	p := config.NewDotenvProvider(filename, config.WithDotenvPrefix("APP_"), config.WithExport())
+
+	v := struct {
+		Name     string
+		Port     int
+		Database struct {
+			Host string
+		}
+		Custom string `env:"TAGGED"`
+	}{}
+
+	hookCounter := assert.Count(t, 4)
+	err := p.Load(context.Background(), &v,
+		config.WithInterceptor(func(providerName string, field reflect.StructField) string {
+			assert.Equal(t, config.EnvProviderName, providerName)
+			return strings.ToUpper(field.Name)
+		}),
+		config.WithFieldSetHook(func(providerName, path, key string) {
+			hookCounter.Inc()
+			assert.Equal(t, config.DotenvProviderName, providerName)
+		}),
+	)
+
+	assert.NilError(t, err)
+	hookCounter.Assert(t)
+	assert.Equal(t, "dotenv", v.Name)
+	assert.Equal(t, 8080, v.Port)
+	assert.Equal(t, "db", v.Database.Host)
+	assert.Equal(t, "tagged", v.Custom)
+	assert.Equal(t, "exported", os.Getenv("DOTENV_TEST_EXPORTED"))
+	assert.Equal(t, "from-env", os.Getenv("DOTENV_TEST_EXISTING"))
+}
+
+func TestDotenvProviderLoadErrors(t *testing.T) {
+	v := struct{}{}
+
+	err := config.NewDotenvProvider(filepath.Join(t.TempDir(), "missing.env")).Load(context.Background(), &v)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	filename := filepath.Join(t.TempDir(), ".env")
+	assert.NilError(t, os.WriteFile(filename, []byte("INVALID"), 0o600))
+
+	err = config.NewDotenvProvider(filename).Load(context.Background(), &v)
+	assert.ErrorIs(t, err, config.ErrInvalidDotenv)
+}
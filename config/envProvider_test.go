@@ -3,6 +3,8 @@ package config_test
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"testing"
 
@@ -34,6 +36,7 @@ func TestEnvProviderLoad(t *testing.T) {
 	tests := []struct {
 		name    string
 		prefix  string
+		opts    []config.LoadOption
 		init    func(t *testing.T) (any, func())
 		wantErr bool
 	}{
@@ -57,6 +60,95 @@ func TestEnvProviderLoad(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:   "success-nested-struct",
+			prefix: "APP_",
+			init: func(t *testing.T) (any, func()) {
+				assert.NilError(t, os.Setenv("APP_Database_Pool_MaxSize", "15"))
+				type Pool struct {
+					MaxSize int
+				}
+				v := struct {
+					Database struct {
+						Pool *Pool
+					}
+				}{}
+
+				return &v, func() {
+					assert.Equal(t, 15, v.Database.Pool.MaxSize)
+					assert.NilError(t, os.Unsetenv("APP_Database_Pool_MaxSize"))
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name:   "success-composite-and-text-values",
+			prefix: "APP_",
+			init: func(t *testing.T) (any, func()) {
+				t.Setenv("APP_Hosts", "a,b,c")
+				t.Setenv("APP_Ports", "80,443")
+				t.Setenv("APP_Limits", "read=10,write=5")
+				t.Setenv("APP_IP", "127.0.0.1")
+				t.Setenv("APP_Endpoint", "https://example.com/api")
+				t.Setenv("APP_Proxy", "http://proxy:8080")
+
+				v := struct {
+					Hosts    []string
+					Ports    []int
+					Limits   map[string]int
+					IP       net.IP
+					Endpoint url.URL
+					Proxy    *url.URL
+				}{}
+
+				return &v, func() {
+					assert.ElementsMatch(t, []string{"a", "b", "c"}, v.Hosts)
+					assert.ElementsMatch(t, []int{80, 443}, v.Ports)
+					assert.MapMatch(t, map[string]int{"read": 10, "write": 5}, v.Limits)
+					assert.Equal(t, "127.0.0.1", v.IP.String())
+					assert.Equal(t, "example.com", v.Endpoint.Host)
+					assert.Equal(t, "proxy:8080", v.Proxy.Host)
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name:   "success-custom-separators",
+			prefix: "APP_",
+			opts: []config.LoadOption{
+				config.WithListSeparator(";"),
+				config.WithKeyValueSeparator(":"),
+			},
+			init: func(t *testing.T) (any, func()) {
+				t.Setenv("APP_Hosts", "a,b;c")
+				t.Setenv("APP_Limits", "read:10;write:5")
+
+				v := struct {
+					Hosts  []string
+					Limits map[string]int
+				}{}
+
+				return &v, func() {
+					assert.ElementsMatch(t, []string{"a,b", "c"}, v.Hosts)
+					assert.MapMatch(t, map[string]int{"read": 10, "write": 5}, v.Limits)
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name:   "invalid-ip",
+			prefix: "APP_",
+			init: func(t *testing.T) (any, func()) {
+				t.Setenv("APP_IP", "not-an-ip")
+
+				v := struct {
+					IP net.IP
+				}{}
+
+				return &v, func() {}
+			},
+			wantErr: true,
+		},
 		{
 			name: "success-unexported-field",
 			init: func(t *testing.T) (any, func()) {
@@ -123,7 +215,7 @@ func TestEnvProviderLoad(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			p := config.NewEnvProviderWith(tt.prefix)
 			v, asserts := tt.init(t)
-			err := p.Load(context.Background(), v)
+			err := p.Load(context.Background(), v, tt.opts...)
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"strings"
+	"time"
 )
 
 var (
@@ -11,6 +13,17 @@ var (
 	ErrNonPointer          = errors.New("cannot pass non pointer values")
 	ErrNonStruct           = errors.New("cannot load config to non struct value")
 	ErrMustImplementGetter = errors.New("must implement flag.Getter interface")
+	ErrRequiredField       = errors.New("required field was not provided")
+	ErrInvalidPollInterval = errors.New("poll interval must be positive")
+	ErrInvalidDotenv       = errors.New("invalid dotenv syntax")
+	ErrInvalidProfile      = errors.New("profile name cannot contain path separator")
+	ErrInvalidField        = errors.New("field value is not valid")
+	ErrInvalidRule         = errors.New("invalid validation rule")
+	ErrRemoteUnavailable   = errors.New("remote configuration is unavailable")
+	ErrRemoteStatus        = errors.New("unexpected remote configuration response status")
+	ErrUnresolvedReference = errors.New("cannot resolve reference")
+	ErrReferenceCycle      = errors.New("reference cycle detected")
+	ErrUnknownKey          = errors.New("key does not match any field")
 )
 
 func wrapErrMustImplementGetter(f flag.Flag) error {
@@ -20,3 +33,51 @@ func wrapErrMustImplementGetter(f flag.Flag) error {
 func wrapErrDuplicateKey(key string) error {
 	return fmt.Errorf("key '%v': %w", key, ErrDuplicateKey)
 }
+
+func wrapErrRequiredField(path string) error {
+	return fmt.Errorf("field '%v': %w", path, ErrRequiredField)
+}
+
+func wrapErrInvalidDefault(path string, err error) error {
+	return fmt.Errorf("invalid default value for field '%v': %w", path, err)
+}
+
+func wrapErrInvalidPollInterval(interval time.Duration) error {
+	return fmt.Errorf("interval '%v': %w", interval, ErrInvalidPollInterval)
+}
+
+func wrapErrInvalidDotenv(line int) error {
+	return fmt.Errorf("line %v: %w", line, ErrInvalidDotenv)
+}
+
+func wrapErrInvalidProfile(profile string) error {
+	return fmt.Errorf("profile '%v': %w", profile, ErrInvalidProfile)
+}
+
+func wrapErrInvalidRule(rule string) error {
+	return fmt.Errorf("rule '%v': %w", rule, ErrInvalidRule)
+}
+
+func wrapErrRemoteUnavailable(url string, err error) error {
+	return fmt.Errorf("url '%v': %w: %w", url, ErrRemoteUnavailable, err)
+}
+
+func wrapErrRemoteStatus(url string, status int) error {
+	return fmt.Errorf("url '%v' returned %v: %w", url, status, ErrRemoteStatus)
+}
+
+func wrapErrUnresolvedReference(path, name string) error {
+	return fmt.Errorf("field '%v' reference '%v': %w", path, name, ErrUnresolvedReference)
+}
+
+func wrapErrUnterminatedReference(path string) error {
+	return fmt.Errorf("field '%v' contains unterminated reference: %w", path, ErrUnresolvedReference)
+}
+
+func wrapErrReferenceCycle(cycle []string) error {
+	return fmt.Errorf("field '%v' references '%v': %w", cycle[0], strings.Join(cycle, " -> "), ErrReferenceCycle)
+}
+
+func wrapErrUnknownKey(key string) error {
+	return fmt.Errorf("key '%v': %w", key, ErrUnknownKey)
+}
@@ -3,8 +3,6 @@ package config_test
 import (
 	"context"
 	"fmt"
-	"reflect"
-	"strings"
 	"time"
 
 	"github.com/Prastiwar/Go-flow/config"
@@ -29,12 +27,11 @@ func Example() {
 		// KeyInterceptor allows to intercept field name before it's used to find it in provider.
 		// It's useful when you want to use different field names than they're defined in struct.
 		// For example you can use tag to define field name and intercept it there.
-		config.WithInterceptor(func(providerName string, field reflect.StructField) string {
-			if providerName == config.EnvProviderName {
-				return strings.ToUpper(field.Name)
-			}
-			return field.Name
-		}),
+		// Built-in naming strategies can be composed to use different convention for each provider.
+		config.WithInterceptor(config.ProviderInterceptor(map[string]config.KeyInterceptor{
+			config.EnvProviderName:  config.ScreamingSnakeCase(),
+			config.FlagProviderName: config.CamelCase(),
+		}, nil)),
 	)
 
 	// Use default values for options in case they are not included in providers.
@@ -3,9 +3,15 @@ package config
 import (
 	"context"
 	"flag"
+	"io"
 	"os"
 )
 
+// resetter is implemented by flag values which accumulate values from multiple occurrences of flag.
+type resetter interface {
+	reset()
+}
+
 type flagProvider struct {
 	set *flag.FlagSet
 }
@@ -27,10 +33,22 @@ func NewFlagProvider(flags ...flag.Flag) *flagProvider {
 	}
 }
 
+// SetOutput sets the destination for usage and error messages. If w is nil, os.Stderr is used.
+func (p *flagProvider) SetOutput(w io.Writer) {
+	p.set.SetOutput(w)
+}
+
 // Load parses flag definitions from the argument list, which should not include the command name.
 // Parsed flag value results are stored in matching v fields. If there is no matching field it
 // will be ignored and it's value will not be overridden.
 func (p *flagProvider) Load(ctx context.Context, v any, opts ...LoadOption) error {
+	// accumulating values are reset, so parsing arguments again does not duplicate them
+	p.set.VisitAll(func(f *flag.Flag) {
+		if r, ok := f.Value.(resetter); ok {
+			r.reset()
+		}
+	})
+
 	err := p.set.Parse(os.Args[1:])
 	if err != nil {
 		return err
@@ -3,6 +3,7 @@ package config_test
 import (
 	"context"
 	"flag"
+	"net"
 	"os"
 	"reflect"
 	"testing"
@@ -173,6 +174,31 @@ func TestFlagProviderLoad(t *testing.T) {
 			options: optionsWithLowerFirtCase,
 			wantErr: false,
 		},
+		{
+			name: "success-nested-struct",
+			flags: []flag.Flag{
+				config.Int32Flag("database.pool.maxSize", desc),
+			},
+			init: func(t *testing.T) (any, func()) {
+				setArgs(
+					"--database.pool.maxSize=25",
+				)
+
+				v := struct {
+					Database struct {
+						Pool struct {
+							MaxSize int
+						}
+					}
+				}{}
+
+				return &v, func() {
+					assert.Equal(t, 25, v.Database.Pool.MaxSize, "nested flag expectation failed")
+				}
+			},
+			options: optionsWithLowerFirtCase,
+			wantErr: false,
+		},
 		{
 			name:  "success-no-flag",
 			flags: []flag.Flag{},
@@ -224,3 +250,33 @@ func TestNewFlagProvider(t *testing.T) {
 
 	_ = config.NewFlagProvider(flag.Flag{})
 }
+
+func TestFlagProviderLoadCompositeValues(t *testing.T) {
+	p := config.NewFlagProvider(
+		config.SliceFlag[string]("hosts", "hosts list"),
+		config.MapFlag[string, int]("limits", "limits per operation"),
+		config.StringFlag("ip", "ip address"),
+	)
+
+	setArgs(
+		"--hosts=a,b",
+		"--hosts=c",
+		"--limits=read=10",
+		"--limits=write=5",
+		"--ip=10.0.0.1",
+	)
+
+	v := struct {
+		Hosts  []string       `flag:"hosts"`
+		Limits map[string]int `flag:"limits"`
+		IP     net.IP         `flag:"ip"`
+	}{}
+
+	// loading twice must not duplicate accumulated values
+	assert.NilError(t, p.Load(context.Background(), &v))
+	assert.NilError(t, p.Load(context.Background(), &v))
+
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, v.Hosts)
+	assert.MapMatch(t, map[string]int{"read": 10, "write": 5}, v.Limits)
+	assert.Equal(t, "10.0.0.1", v.IP.String())
+}
@@ -2,8 +2,13 @@ package config
 
 import (
 	"flag"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/Prastiwar/Go-flow/reflection"
 )
 
 // -- bool Value
@@ -173,6 +178,62 @@ func (d *timeValue) Get() any { return time.Time(*d) }
 
 func (d *timeValue) String() string { return (*time.Time)(d).String() }
 
+// -- slice Value
+type sliceValue[T any] []T
+
+func (s *sliceValue[T]) Set(val string) error {
+	v, err := reflection.Parse(val, []T(nil))
+	if err != nil {
+		return err
+	}
+
+	*s = append(*s, v.([]T)...)
+	return nil
+}
+
+func (s *sliceValue[T]) Get() any { return []T(*s) }
+
+func (s *sliceValue[T]) String() string {
+	elems := make([]string, len(*s))
+	for i, v := range *s {
+		elems[i] = fmt.Sprint(v)
+	}
+	return strings.Join(elems, reflection.DefaultListSeparator)
+}
+
+func (s *sliceValue[T]) reset() { *s = nil }
+
+// -- map Value
+type mapValue[K comparable, V any] map[K]V
+
+func (m *mapValue[K, V]) Set(val string) error {
+	v, err := reflection.Parse(val, map[K]V(nil))
+	if err != nil {
+		return err
+	}
+
+	if *m == nil {
+		*m = make(mapValue[K, V])
+	}
+	for key, value := range v.(map[K]V) {
+		(*m)[key] = value
+	}
+	return nil
+}
+
+func (m *mapValue[K, V]) Get() any { return map[K]V(*m) }
+
+func (m *mapValue[K, V]) String() string {
+	entries := make([]string, 0, len(*m))
+	for key, value := range *m {
+		entries = append(entries, fmt.Sprint(key)+reflection.DefaultKeyValueSeparator+fmt.Sprint(value))
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, reflection.DefaultListSeparator)
+}
+
+func (m *mapValue[K, V]) reset() { *m = nil }
+
 func BoolFlag(name string, usage string) flag.Flag {
 	var value boolValue
 	return CustomFlag(name, usage, &value)
@@ -223,6 +284,23 @@ func TimeFlag(name string, usage string) flag.Flag {
 	return CustomFlag(name, usage, &value)
 }
 
+// SliceFlag creates a flag collecting []T values. Each value is split with reflection.DefaultListSeparator and each
+// element is parsed with reflection.Parse. If the flag is passed multiple times, the values are appended,
+// so both "-tag=a,b" and "-tag=a -tag=b" result in []string{"a", "b"}.
+func SliceFlag[T any](name string, usage string) flag.Flag {
+	var value sliceValue[T]
+	return CustomFlag(name, usage, &value)
+}
+
+// MapFlag creates a flag collecting map[K]V values. Each value is split to entries with
+// reflection.DefaultListSeparator and each entry is split to key and value with reflection.DefaultKeyValueSeparator.
+// If the flag is passed multiple times, the entries are merged, so both "-label=a=1,b=2" and "-label=a=1 -label=b=2"
+// result in map[string]int{"a": 1, "b": 2}.
+func MapFlag[K comparable, V any](name string, usage string) flag.Flag {
+	var value mapValue[K, V]
+	return CustomFlag(name, usage, &value)
+}
+
 // CustomFlag creates a flag with specified name, usage and flag.Value implementation.
 func CustomFlag(name string, usage string, value flag.Value) flag.Flag {
 	return flag.Flag{
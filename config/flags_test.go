@@ -214,6 +214,42 @@ func TestFlags(t *testing.T) {
 				assert.NilError(t, err)
 			},
 		},
+		{
+			name:    "success-slice",
+			set:     "1,2,3",
+			flag:    config.SliceFlag[int](name, usage),
+			wantGet: []int{1, 2, 3},
+			assertion: func(t *testing.T, err error) {
+				assert.NilError(t, err)
+			},
+		},
+		{
+			name:    "invalid-slice",
+			set:     "1,two",
+			flag:    config.SliceFlag[int](name, usage),
+			wantGet: nil,
+			assertion: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:    "success-map",
+			set:     "a=1,b=2",
+			flag:    config.MapFlag[string, int](name, usage),
+			wantGet: map[string]int{"a": 1, "b": 2},
+			assertion: func(t *testing.T, err error) {
+				assert.NilError(t, err)
+			},
+		},
+		{
+			name:    "invalid-map",
+			set:     "a",
+			flag:    config.MapFlag[string, int](name, usage),
+			wantGet: nil,
+			assertion: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
 		{
 			name:    "invalid-time",
 			set:     "2030-10-12",
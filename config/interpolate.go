@@ -0,0 +1,227 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/Prastiwar/Go-flow/exception"
+)
+
+// interpolator resolves ${...} expressions in string fields of loaded struct.
+type interpolator struct {
+	root      reflect.Value
+	secret    func(path string) bool
+	resolved  map[string]string
+	resolving []string
+	tainted   map[string]struct{}
+}
+
+// interpolate replaces ${name} and ${name:-default} expressions in each val string field with value of referenced
+// field or environment variable. Name is first looked up as dot-separated path of fields matched exactly by name
+// or json tag (e.g. ${Database.host}) and then as environment variable, so ${PORT} refers to PORT environment
+// variable even if there is Port field. Default is used if referenced value is not found or empty and "$${"
+// is replaced with literal "${". Referenced string fields are resolved first, so reference cycle is returned
+// as ErrReferenceCycle and missing reference without default as ErrUnresolvedReference.
+// Fields for which secret reports true are not expanded, so their values never appear in errors, but they can
+// still be referenced by other fields. Paths of fields which expanded value of secret field (directly or through
+// other field) are returned, so they can be treated as secret too.
+func interpolate(val reflect.Value, secret func(path string) bool) (map[string]struct{}, error) {
+	in := &interpolator{
+		root:     val,
+		secret:   secret,
+		resolved: make(map[string]string),
+		tainted:  make(map[string]struct{}),
+	}
+
+	var errs []error
+	in.walk(val, "", &errs)
+
+	if len(errs) > 0 {
+		return nil, exception.Aggregate(errs...)
+	}
+	return in.tainted, nil
+}
+
+// walk resolves each string field of val. Nested struct fields and non-nil pointer-to-struct fields are walked recursively.
+func (in *interpolator) walk(val reflect.Value, path string, errs *[]error) {
+	valType := val.Type()
+	for i := 0; i < val.NumField(); i++ {
+		sf := valType.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		field := val.Field(i)
+		fieldPath := joinPath(path, sf.Name)
+		switch {
+		case isNestedStruct(field.Type()):
+			in.walk(field, fieldPath, errs)
+		case field.Kind() == reflect.Pointer && isNestedStruct(field.Type().Elem()):
+			if !field.IsNil() {
+				in.walk(field.Elem(), fieldPath, errs)
+			}
+		case field.Kind() == reflect.String:
+			if _, err := in.resolveField(fieldPath, field); err != nil {
+				*errs = append(*errs, err)
+			}
+		}
+	}
+}
+
+// resolveField expands expressions in field value and stores the result in the field.
+// Value of secret field is returned as it is.
+func (in *interpolator) resolveField(path string, field reflect.Value) (string, error) {
+	if s, ok := in.resolved[path]; ok {
+		return s, nil
+	}
+
+	if in.secret(path) {
+		return field.String(), nil
+	}
+
+	for _, p := range in.resolving {
+		if p == path {
+			return "", wrapErrReferenceCycle(append(in.resolving, path))
+		}
+	}
+
+	in.resolving = append(in.resolving, path)
+	s, err := in.expand(path, field.String())
+	in.resolving = in.resolving[:len(in.resolving)-1]
+	if err != nil {
+		return "", err
+	}
+
+	in.resolved[path] = s
+	field.SetString(s)
+	return s, nil
+}
+
+// expand returns s with expressions replaced by referenced values. path is the field containing s.
+func (in *interpolator) expand(path, s string) (string, error) {
+	var b strings.Builder
+	for {
+		i := strings.IndexByte(s, '$')
+		if i < 0 {
+			b.WriteString(s)
+			return b.String(), nil
+		}
+
+		b.WriteString(s[:i])
+		s = s[i:]
+
+		switch {
+		case strings.HasPrefix(s, "$${"):
+			b.WriteString("${")
+			s = s[3:]
+			continue
+		case !strings.HasPrefix(s, "${"):
+			b.WriteByte('$')
+			s = s[1:]
+			continue
+		}
+
+		end := strings.IndexByte(s, '}')
+		if end < 0 {
+			// rest of the value is not included, so it cannot leak to logs
+			return "", wrapErrUnterminatedReference(path)
+		}
+
+		expr := s[2:end]
+		s = s[end+1:]
+
+		name, def, hasDefault := strings.Cut(expr, ":-")
+		value, ok, err := in.lookup(name)
+		if err != nil {
+			return "", err
+		}
+
+		if hasDefault && value == "" {
+			value = def
+		} else if !ok {
+			return "", wrapErrUnresolvedReference(path, name)
+		}
+
+		b.WriteString(value)
+	}
+}
+
+// lookup returns value of field at name path or environment variable with the name. It reports whether it was found.
+// If the field is secret or contains secret value, the field being resolved is marked as tainted.
+func (in *interpolator) lookup(name string) (string, bool, error) {
+	if field, path, ok := findField(in.root, name); ok {
+		value := fmt.Sprint(field.Interface())
+		if field.Kind() == reflect.String {
+			s, err := in.resolveField(path, field)
+			if err != nil {
+				return "", true, err
+			}
+			value = s
+		}
+
+		in.taint(path)
+		return value, true, nil
+	}
+
+	value, ok := os.LookupEnv(name)
+	return value, ok, nil
+}
+
+// taint marks currently resolved field as tainted if field at referenced path is secret or tainted.
+func (in *interpolator) taint(referenced string) {
+	if len(in.resolving) == 0 {
+		return
+	}
+
+	_, tainted := in.tainted[referenced]
+	if tainted || in.secret(referenced) {
+		in.tainted[in.resolving[len(in.resolving)-1]] = struct{}{}
+	}
+}
+
+// findField returns val field found by dot-separated path of field names or json tag names and its path
+// of struct field names. Pointers are dereferenced.
+func findField(val reflect.Value, name string) (reflect.Value, string, bool) {
+	var path string
+	for _, segment := range strings.Split(name, ".") {
+		for val.Kind() == reflect.Pointer {
+			if val.IsNil() {
+				return reflect.Value{}, "", false
+			}
+			val = val.Elem()
+		}
+
+		if val.Kind() != reflect.Struct {
+			return reflect.Value{}, "", false
+		}
+
+		sf, ok := fieldBySegment(val.Type(), segment)
+		if !ok {
+			return reflect.Value{}, "", false
+		}
+
+		val = val.FieldByIndex(sf.Index)
+		path = joinPath(path, sf.Name)
+	}
+
+	return val, path, true
+}
+
+// fieldBySegment returns exported t field with name or json tag name equal to segment. Names are compared
+// case-sensitively, so field does not shadow environment variable differing only in case.
+func fieldBySegment(t reflect.Type, segment string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		tag, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if sf.Name == segment || (tag != "" && tag == segment) {
+			return sf, true
+		}
+	}
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,250 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Prastiwar/Go-flow/config"
+	"github.com/Prastiwar/Go-flow/exception"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+func TestSourceLoadInterpolation(t *testing.T) {
+	checkEnvironment(t)
+	t.Setenv("DB_HOST", "db.local")
+	t.Setenv("EMPTY", "")
+
+	type Database struct {
+		Port int    `json:"port"`
+		Name string `json:"name"`
+		URL  string
+	}
+
+	v := struct {
+		Database   Database
+		Host       string
+		Replica    *Database
+		Defaulted  string
+		EmptyEnv   string
+		Literal    string
+		Dollar     string
+		Undeclared string
+	}{
+		Database: Database{
+			Port: 5432,
+			Name: "app",
+			URL:  "postgres://${DB_HOST}:${Database.port}/${Database.Name}",
+		},
+		Host:      "${DB_HOST}",
+		Replica:   &Database{URL: "${Database.URL}?replica=true"},
+		Defaulted: "${MISSING_VAR:-fallback}",
+		EmptyEnv:  "${EMPTY:-fallback}",
+		Literal:   "$${DB_HOST}",
+		Dollar:    "cost: $5",
+	}
+
+	err := config.Provide().Load(context.Background(), &v, config.WithInterpolation())
+
+	assert.NilError(t, err)
+	assert.Equal(t, "postgres://db.local:5432/app", v.Database.URL)
+	assert.Equal(t, "db.local", v.Host)
+	assert.Equal(t, "postgres://db.local:5432/app?replica=true", v.Replica.URL)
+	assert.Equal(t, "fallback", v.Defaulted)
+	assert.Equal(t, "fallback", v.EmptyEnv)
+	assert.Equal(t, "${DB_HOST}", v.Literal)
+	assert.Equal(t, "cost: $5", v.Dollar)
+}
+
+func TestSourceLoadInterpolationErrors(t *testing.T) {
+	checkEnvironment(t)
+
+	tests := []struct {
+		name string
+		init func(t *testing.T) (any, []config.LoadOption, func(error))
+	}{
+		{
+			name: "unresolved",
+			init: func(t *testing.T) (any, []config.LoadOption, func(error)) {
+				v := struct {
+					Nested struct {
+						URL string
+					}
+				}{}
+				v.Nested.URL = "http://${MISSING_HOST}/"
+
+				return &v, nil, func(err error) {
+					assert.ErrorIs(t, err, config.ErrUnresolvedReference)
+					assert.ErrorWith(t, err, "field 'Nested.URL' reference 'MISSING_HOST'")
+				}
+			},
+		},
+		{
+			name: "unterminated",
+			init: func(t *testing.T) (any, []config.LoadOption, func(error)) {
+				v := struct {
+					Key string
+				}{Key: "hunter${2"}
+
+				return &v, nil, func(err error) {
+					assert.ErrorIs(t, err, config.ErrUnresolvedReference)
+					assert.ErrorWith(t, err, "field 'Key'")
+					assert.Equal(t, false, strings.Contains(err.Error(), "${2"), "value should not be included in error")
+				}
+			},
+		},
+		{
+			name: "cycle",
+			init: func(t *testing.T) (any, []config.LoadOption, func(error)) {
+				v := struct {
+					A string
+					B string
+					C string
+				}{A: "${B}", B: "${C}", C: "x-${A}"}
+
+				return &v, nil, func(err error) {
+					assert.ErrorIs(t, err, config.ErrReferenceCycle)
+					assert.ErrorType(t, err, exception.AggregatedError{})
+					assert.ErrorWith(t, err, "field 'A' references 'A -> B -> C -> A'")
+				}
+			},
+		},
+		{
+			name: "self-reference",
+			init: func(t *testing.T) (any, []config.LoadOption, func(error)) {
+				v := struct {
+					Key string
+				}{Key: "${Key}"}
+
+				return &v, nil, func(err error) {
+					assert.ErrorIs(t, err, config.ErrReferenceCycle)
+					assert.ErrorWith(t, err, "'Key -> Key'")
+				}
+			},
+		},
+		{
+			name: "secret-not-interpolated",
+			init: func(t *testing.T) (any, []config.LoadOption, func(error)) {
+				v := struct {
+					Password string `secret:"true"`
+					DSN      string
+				}{Password: "hunter${2", DSN: "user:${Password}@db"}
+
+				return &v, nil, func(err error) {
+					assert.NilError(t, err)
+					assert.Equal(t, "hunter${2", v.Password)
+					assert.Equal(t, "user:hunter${2@db", v.DSN)
+				}
+			},
+		},
+		{
+			name: "env-not-shadowed",
+			init: func(t *testing.T) (any, []config.LoadOption, func(error)) {
+				t.Setenv("PORT", "9000")
+				v := struct {
+					Port string
+					Addr string
+				}{Port: "8000", Addr: "localhost:${PORT}"}
+
+				return &v, nil, func(err error) {
+					assert.NilError(t, err)
+					assert.Equal(t, "localhost:9000", v.Addr)
+				}
+			},
+		},
+		{
+			name: "not-enabled",
+			init: func(t *testing.T) (any, []config.LoadOption, func(error)) {
+				v := struct {
+					Key string
+				}{Key: "${MISSING_HOST}"}
+
+				return &v, []config.LoadOption{config.WithIgnoreGlobalOptions()}, func(err error) {
+					assert.NilError(t, err)
+					assert.Equal(t, "${MISSING_HOST}", v.Key)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, opts, check := tt.init(t)
+
+			if opts == nil {
+				opts = []config.LoadOption{config.WithInterpolation()}
+			}
+
+			err := config.Provide().LoadWithOptions(context.Background(), v, opts...)
+
+			check(err)
+		})
+	}
+}
+
+func TestSourceLoadInterpolationSecretsProvider(t *testing.T) {
+	checkEnvironment(t)
+
+	dir := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "Password"), []byte("p${ass"), 0o600))
+
+	v := struct {
+		Password string
+	}{}
+
+	err := config.Provide(config.NewSecretsProvider(dir)).Load(context.Background(), &v, config.WithInterpolation())
+
+	assert.NilError(t, err)
+	assert.Equal(t, "p${ass", v.Password)
+}
+
+func TestSourceLoadInterpolationReport(t *testing.T) {
+	checkEnvironment(t)
+	t.Setenv("DB_HOST", "db.local")
+	t.Setenv("Host", "${DB_HOST}")
+
+	v := struct {
+		Host string
+	}{}
+
+	var report config.LoadReport
+	err := config.Provide(config.NewEnvProvider()).Load(context.Background(), &v, config.WithReport(&report), config.WithInterpolation())
+
+	assert.NilError(t, err)
+	assert.Equal(t, "db.local", v.Host)
+	assert.Equal(t, "Host=db.local (env)", report.String())
+}
+
+func TestSourceLoadInterpolationSecretReport(t *testing.T) {
+	checkEnvironment(t)
+	t.Setenv("Password", "hunter2")
+	t.Setenv("DSN", "pg://u:${Password}@h")
+	t.Setenv("URL", "${DSN}?sslmode=disable")
+	t.Setenv("Host", "${Name}.local")
+
+	v := struct {
+		Password string `secret:"true"`
+		DSN      string
+		URL      string
+		Name     string
+		Host     string
+	}{Name: "db"}
+
+	var report config.LoadReport
+	err := config.Provide(config.NewEnvProvider()).Load(context.Background(), &v, config.WithReport(&report), config.WithInterpolation())
+
+	assert.NilError(t, err)
+	assert.Equal(t, "pg://u:hunter2@h", v.DSN)
+	assert.Equal(t, "pg://u:hunter2@h?sslmode=disable", v.URL)
+	assert.Equal(t, false, strings.Contains(report.String(), "hunter2"))
+
+	dsn, _ := report.Field("DSN")
+	assert.Equal(t, true, dsn.Secret)
+	url, _ := report.Field("URL")
+	assert.Equal(t, true, url.Secret)
+	host, _ := report.Field("Host")
+	assert.Equal(t, false, host.Secret)
+	assert.Equal(t, "db.local", host.Value)
+}
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// ScreamingSnakeCase returns KeyInterceptor converting field name to SCREAMING_SNAKE_CASE, e.g. HTTPTimeout
+// to HTTP_TIMEOUT. It's the common convention of environment variable names.
+func ScreamingSnakeCase() KeyInterceptor {
+	return func(providerName string, field reflect.StructField) string {
+		return strings.ToUpper(strings.Join(splitWords(field.Name), "_"))
+	}
+}
+
+// SnakeCase returns KeyInterceptor converting field name to snake_case, e.g. HTTPTimeout to http_timeout.
+func SnakeCase() KeyInterceptor {
+	return func(providerName string, field reflect.StructField) string {
+		return strings.ToLower(strings.Join(splitWords(field.Name), "_"))
+	}
+}
+
+// KebabCase returns KeyInterceptor converting field name to kebab-case, e.g. HTTPTimeout to http-timeout.
+// It's the common convention of command line flag names.
+func KebabCase() KeyInterceptor {
+	return func(providerName string, field reflect.StructField) string {
+		return strings.ToLower(strings.Join(splitWords(field.Name), "-"))
+	}
+}
+
+// CamelCase returns KeyInterceptor converting field name to camelCase, e.g. HTTPTimeout to httpTimeout
+// and UserID to userId.
+func CamelCase() KeyInterceptor {
+	return func(providerName string, field reflect.StructField) string {
+		words := splitWords(field.Name)
+		for i, w := range words {
+			w = strings.ToLower(w)
+			if i > 0 {
+				r := []rune(w)
+				r[0] = unicode.ToUpper(r[0])
+				w = string(r)
+			}
+			words[i] = w
+		}
+		return strings.Join(words, "")
+	}
+}
+
+// JsonTagFirst returns KeyInterceptor using name from `json` tag of the field, so the same keys are used
+// as in JSON files. If the field has no json tag name, fallback is called or in case it's nil the exact
+// field name is used.
+func JsonTagFirst(fallback KeyInterceptor) KeyInterceptor {
+	return func(providerName string, field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+
+		if fallback == nil {
+			return field.Name
+		}
+		return fallback(providerName, field)
+	}
+}
+
+// ProviderInterceptor returns KeyInterceptor which calls strategy registered for provider name, e.g.
+// ScreamingSnakeCase for EnvProviderName and KebabCase for FlagProviderName. If there is no strategy
+// for provider, fallback is called or in case it's nil the exact field name is used.
+func ProviderInterceptor(strategies map[string]KeyInterceptor, fallback KeyInterceptor) KeyInterceptor {
+	return func(providerName string, field reflect.StructField) string {
+		if strategy, ok := strategies[providerName]; ok && strategy != nil {
+			return strategy(providerName, field)
+		}
+
+		if fallback == nil {
+			return field.Name
+		}
+		return fallback(providerName, field)
+	}
+}
+
+// splitWords splits Go identifier into words. Upper case letter starts a new word unless it's part of acronym,
+// so acronym ends before the last upper case letter followed by lower case one, e.g. HTTPTimeout is split
+// into HTTP and Timeout. Digits are part of the preceding word and underscores and hyphens separate words.
+func splitWords(name string) []string {
+	runes := []rune(name)
+
+	var words []string
+	start := 0
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == '_' || r == '-' {
+			if i > start {
+				words = append(words, string(runes[start:i]))
+			}
+			start = i + 1
+			continue
+		}
+
+		if i == start || !unicode.IsUpper(r) {
+			continue
+		}
+
+		prev := runes[i-1]
+		nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+
+	if start < len(runes) {
+		words = append(words, string(runes[start:]))
+	}
+
+	return words
+}
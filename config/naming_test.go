@@ -0,0 +1,110 @@
+package config_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Prastiwar/Go-flow/config"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+func TestNamingStrategies(t *testing.T) {
+	tests := []struct {
+		name      string
+		field     string
+		screaming string
+		snake     string
+		kebab     string
+		camel     string
+	}{
+		{name: "single-word", field: "Port", screaming: "PORT", snake: "port", kebab: "port", camel: "port"},
+		{name: "words", field: "ConnectionString", screaming: "CONNECTION_STRING", snake: "connection_string", kebab: "connection-string", camel: "connectionString"},
+		{name: "leading-acronym", field: "HTTPTimeout", screaming: "HTTP_TIMEOUT", snake: "http_timeout", kebab: "http-timeout", camel: "httpTimeout"},
+		{name: "trailing-acronym", field: "UserID", screaming: "USER_ID", snake: "user_id", kebab: "user-id", camel: "userId"},
+		{name: "inner-acronym", field: "MaxHTTPConns", screaming: "MAX_HTTP_CONNS", snake: "max_http_conns", kebab: "max-http-conns", camel: "maxHttpConns"},
+		{name: "acronym", field: "URL", screaming: "URL", snake: "url", kebab: "url", camel: "url"},
+		{name: "digits", field: "S3Bucket", screaming: "S3_BUCKET", snake: "s3_bucket", kebab: "s3-bucket", camel: "s3Bucket"},
+		{name: "digits-acronym", field: "V2API", screaming: "V2_API", snake: "v2_api", kebab: "v2-api", camel: "v2Api"},
+		{name: "underscore", field: "Db_Name", screaming: "DB_NAME", snake: "db_name", kebab: "db-name", camel: "dbName"},
+		{name: "non-ascii", field: "ÜberÉtat", screaming: "ÜBER_ÉTAT", snake: "über_état", kebab: "über-état", camel: "überÉtat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field := reflect.StructField{Name: tt.field}
+
+			assert.Equal(t, tt.screaming, config.ScreamingSnakeCase()(config.EnvProviderName, field))
+			assert.Equal(t, tt.snake, config.SnakeCase()(config.EnvProviderName, field))
+			assert.Equal(t, tt.kebab, config.KebabCase()(config.EnvProviderName, field))
+			assert.Equal(t, tt.camel, config.CamelCase()(config.EnvProviderName, field))
+		})
+	}
+}
+
+func TestJsonTagFirst(t *testing.T) {
+	typ := reflect.TypeOf(struct {
+		Tagged   string `json:"tagged_name,omitempty"`
+		Options  string `json:",omitempty"`
+		Ignored  string `json:"-"`
+		Untagged string
+	}{})
+
+	tests := []struct {
+		name     string
+		fallback config.KeyInterceptor
+		want     []string
+	}{
+		{
+			name:     "without-fallback",
+			fallback: nil,
+			want:     []string{"tagged_name", "Options", "Ignored", "Untagged"},
+		},
+		{
+			name:     "with-fallback",
+			fallback: config.KebabCase(),
+			want:     []string{"tagged_name", "options", "ignored", "untagged"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := config.JsonTagFirst(tt.fallback)
+			for i, want := range tt.want {
+				assert.Equal(t, want, interceptor(config.ReaderProviderName, typ.Field(i)))
+			}
+		})
+	}
+}
+
+func TestProviderInterceptor(t *testing.T) {
+	checkEnvironment(t)
+	t.Setenv("HTTP_TIMEOUT", "5s")
+	t.Setenv("DATABASE_MAX_CONNS", "10")
+	setArgs("--http-timeout=10s")
+
+	type Config struct {
+		HTTPTimeout string
+		Database    struct {
+			MaxConns int
+		}
+	}
+
+	interceptor := config.ProviderInterceptor(map[string]config.KeyInterceptor{
+		config.EnvProviderName:  config.ScreamingSnakeCase(),
+		config.FlagProviderName: config.KebabCase(),
+	}, config.CamelCase())
+
+	assert.Equal(t, "httpTimeout", interceptor(config.ReaderProviderName, reflect.StructField{Name: "HTTPTimeout"}))
+
+	var envCfg Config
+	err := config.NewEnvProvider().Load(context.Background(), &envCfg, config.WithInterceptor(interceptor))
+	assert.NilError(t, err)
+	assert.Equal(t, "5s", envCfg.HTTPTimeout)
+	assert.Equal(t, 10, envCfg.Database.MaxConns)
+
+	var flagCfg Config
+	err = config.NewFlagProvider(config.StringFlag("http-timeout", "")).Load(context.Background(), &flagCfg, config.WithInterceptor(interceptor))
+	assert.NilError(t, err)
+	assert.Equal(t, "10s", flagCfg.HTTPTimeout)
+}
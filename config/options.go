@@ -1,17 +1,49 @@
 package config
 
-import "reflect"
+import (
+	"reflect"
+	"strings"
+
+	"github.com/Prastiwar/Go-flow/reflection"
+)
 
 // KeyInterceptor allows to intercept field name before it's used to find it in provider.
 // It's useful when you want to use different field names than they're defined in struct.
 // For example you can use tag to define field name and intercept it there.
+// For nested struct fields it's called for each field in the path and the results are joined with
+// provider's KeySeparator. Use KeyPathInterceptor if the key depends on parent fields.
 type KeyInterceptor func(providerName string, field reflect.StructField) string
 
+// KeyPathInterceptor allows to intercept the full key of nested field before it's used to find it in provider.
+// path contains struct fields from the loaded value to the field, e.g. Database, Pool and MaxSize fields, so
+// Database.Pool.MaxSize can be mapped to DB_POOL_MAX. Returning empty string falls back to joining keys of
+// each path field (see LoadOptions.Intercept) with provider's KeySeparator.
+type KeyPathInterceptor func(providerName string, path []reflect.StructField) string
+
+// FieldSetHook is called each time provider sets field value. path is a dot-separated path of struct
+// field names from the loaded value to the field and key is the name used to find the value in provider.
+type FieldSetHook func(providerName, path, key string)
+
 type LoadOption func(*LoadOptions)
 
 // LoadOptions stores settings to control behaviour in configuration loading.
 type LoadOptions struct {
-	Interceptor KeyInterceptor
+	Interceptor     KeyInterceptor
+	PathInterceptor KeyPathInterceptor
+	FieldSetHook    FieldSetHook
+	Report          *LoadReport
+
+	// Strict makes reader and file providers return ErrUnknownKey for keys not matching any field.
+	Strict bool
+	// Interpolation turns on resolving ${...} expressions in string fields after Source is loaded.
+	Interpolation bool
+
+	// ListSeparator separates slice elements and map entries parsed from string values.
+	// If empty, reflection.DefaultListSeparator is used.
+	ListSeparator string
+	// KeyValueSeparator separates map entry key and value parsed from string values.
+	// If empty, reflection.DefaultKeyValueSeparator is used.
+	KeyValueSeparator string
 }
 
 func NewLoadOptions(options ...LoadOption) *LoadOptions {
@@ -29,6 +61,72 @@ func WithInterceptor(i KeyInterceptor) LoadOption {
 	}
 }
 
+// WithPathInterceptor sets interceptor of the full key of nested fields. It takes precedence over tags
+// and KeyInterceptor unless it returns empty string.
+func WithPathInterceptor(i KeyPathInterceptor) LoadOption {
+	return func(s *LoadOptions) {
+		s.PathInterceptor = i
+	}
+}
+
+// WithListSeparator sets separator of slice elements and map entries, e.g. ";" for "a;b;c" value.
+func WithListSeparator(sep string) LoadOption {
+	return func(s *LoadOptions) {
+		s.ListSeparator = sep
+	}
+}
+
+// WithKeyValueSeparator sets separator of map entry key and value, e.g. ":" for "a:1,b:2" value.
+func WithKeyValueSeparator(sep string) LoadOption {
+	return func(s *LoadOptions) {
+		s.KeyValueSeparator = sep
+	}
+}
+
+// WithReport enables provenance tracing in Source loading. After load, report contains the provider name
+// and the overridden values of each set field. Values of fields tagged with `secret:"true"` are redacted
+// when the report is printed.
+func WithReport(report *LoadReport) LoadOption {
+	return func(s *LoadOptions) {
+		s.Report = report
+	}
+}
+
+// WithStrict enables strict mode of reader and file providers, so keys in the document which do not map to any
+// struct field are returned as ErrUnknownKey errors. It helps to catch typos in configuration files.
+// The decoder must be able to decode the document into map[string]any. Keys are matched with fields named by
+// decoder tags, e.g. `yaml` tag with `json` fallback for datas.Yaml() (see NewReaderProvider).
+func WithStrict() LoadOption {
+	return func(s *LoadOptions) {
+		s.Strict = true
+	}
+}
+
+// WithInterpolation enables resolving ${ENV}, ${key.path} and ${VAR:-default} expressions in string
+// fields after Source is loaded. Without it values containing "${" are kept as they are.
+func WithInterpolation() LoadOption {
+	return func(s *LoadOptions) {
+		s.Interpolation = true
+	}
+}
+
+// WithFieldSetHook adds hook called each time provider sets field value. Hooks added before are
+// still called, so multiple hooks can observe the same loading process.
+func WithFieldSetHook(hook FieldSetHook) LoadOption {
+	return func(s *LoadOptions) {
+		prev := s.FieldSetHook
+		if prev == nil {
+			s.FieldSetHook = hook
+			return
+		}
+
+		s.FieldSetHook = func(providerName, path, key string) {
+			prev(providerName, path, key)
+			hook(providerName, path, key)
+		}
+	}
+}
+
 // WithIgnoreGlobalOptions returns empty LoadOption to indicate no shared options should be used and
 // no additional configuration is provided. This behaviour applies to Source provider.
 // See Source.Load() for more information.
@@ -36,11 +134,57 @@ func WithIgnoreGlobalOptions() LoadOption {
 	return func(s *LoadOptions) {}
 }
 
-// Intercept provides default behaviour in case Interceptor is not set the exact field name will be used.
+// Intercept returns key for f field used by provider with providerName. Tag named after provider (e.g. `env:"DB_NAME"`
+// or `flag:"db-name"`) has the highest priority, then `config:"dbName"` tag shared by providers using FieldSetter.
+// If none of them is set, Interceptor is called or in case it's not set the exact field name will be used.
 func (o *LoadOptions) Intercept(providerName string, f reflect.StructField) string {
+	if key, ok := f.Tag.Lookup(providerName); ok && key != "" {
+		return key
+	}
+
+	if key, ok := f.Tag.Lookup(ConfigTag); ok && key != "" {
+		return key
+	}
+
 	if o.Interceptor == nil {
 		return f.Name
 	}
 
 	return o.Interceptor(providerName, f)
 }
+
+// InterceptPath returns key for field at path used by provider with providerName. PathInterceptor is called
+// with the whole path if it's set. If it's not set or returns empty string, each path field is intercepted
+// with Intercept and the results are joined with provider's KeySeparator.
+func (o *LoadOptions) InterceptPath(providerName string, path []reflect.StructField) string {
+	if o.PathInterceptor != nil {
+		if key := o.PathInterceptor(providerName, path); key != "" {
+			return key
+		}
+	}
+
+	keys := make([]string, len(path))
+	for i, f := range path {
+		keys[i] = o.Intercept(providerName, f)
+	}
+	return strings.Join(keys, KeySeparator(providerName))
+}
+
+// parseOptions returns reflection.ParseOption list with configured separators.
+func (o *LoadOptions) parseOptions() []reflection.ParseOption {
+	var opts []reflection.ParseOption
+	if o.ListSeparator != "" {
+		opts = append(opts, reflection.WithListSeparator(o.ListSeparator))
+	}
+	if o.KeyValueSeparator != "" {
+		opts = append(opts, reflection.WithKeyValueSeparator(o.KeyValueSeparator))
+	}
+	return opts
+}
+
+// notifyFieldSet calls FieldSetHook if it's set.
+func (o *LoadOptions) notifyFieldSet(providerName, path, key string) {
+	if o.FieldSetHook != nil {
+		o.FieldSetHook(providerName, path, key)
+	}
+}
@@ -0,0 +1,142 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Prastiwar/Go-flow/datas"
+)
+
+type ProfileOption func(*ProfileOptions)
+
+// ProfileOptions stores settings to control how profile overlay is selected.
+type ProfileOptions struct {
+	// Profile is used if profile was not selected with EnvVar or Flag.
+	Profile string
+	// EnvVar is a name of environment variable selecting the profile.
+	EnvVar string
+	// Flag is a name of command line flag selecting the profile.
+	Flag string
+}
+
+func NewProfileOptions(options ...ProfileOption) *ProfileOptions {
+	opts := &ProfileOptions{}
+	for _, o := range options {
+		o(opts)
+	}
+
+	return opts
+}
+
+// WithProfile sets the default profile used if it's not selected by environment variable or flag.
+func WithProfile(profile string) ProfileOption {
+	return func(o *ProfileOptions) {
+		o.Profile = profile
+	}
+}
+
+// WithProfileEnv sets name of environment variable selecting the profile, e.g. "APP_PROFILE".
+func WithProfileEnv(name string) ProfileOption {
+	return func(o *ProfileOptions) {
+		o.EnvVar = name
+	}
+}
+
+// WithProfileFlag sets name of command line flag selecting the profile, e.g. "profile" for "--profile=staging".
+// The flag has the highest priority and it's looked up in os.Args without defining it in any flag.FlagSet.
+// If flag provider is used too, the flag should be declared there as well, so it's not reported as undefined.
+func WithProfileFlag(name string) ProfileOption {
+	return func(o *ProfileOptions) {
+		o.Flag = name
+	}
+}
+
+type profileProvider struct {
+	filename string
+	decoder  datas.ReaderUnmarshaler
+	options  ProfileOptions
+}
+
+// NewProfileProvider returns a new provider loading base file found at filename and overlay file of selected
+// profile on top of it. Overlay filename is the base one with profile inserted before extension, so "staging"
+// profile of "config.json" is loaded from "config.staging.json". Overlay is decoded into already loaded value,
+// so with decoders like datas.Json() nested objects are deep-merged and overlay needs to contain only changed keys.
+// If no profile is selected, only the base file is loaded.
+func NewProfileProvider(filename string, decoder datas.ReaderUnmarshaler, opts ...ProfileOption) *profileProvider {
+	return &profileProvider{
+		filename: filename,
+		decoder:  decoder,
+		options:  *NewProfileOptions(opts...),
+	}
+}
+
+// Profile returns selected profile name. Flag has the highest priority, then environment variable and
+// the default profile. Empty string is returned if none of them is set.
+func (p *profileProvider) Profile() string {
+	if p.options.Flag != "" {
+		if profile, ok := lookupArg(os.Args[1:], p.options.Flag); ok {
+			return profile
+		}
+	}
+
+	if p.options.EnvVar != "" {
+		if profile, ok := os.LookupEnv(p.options.EnvVar); ok && profile != "" {
+			return profile
+		}
+	}
+
+	return p.options.Profile
+}
+
+// OverlayFilename returns filename of profile overlay.
+func (p *profileProvider) OverlayFilename(profile string) string {
+	ext := filepath.Ext(p.filename)
+	return strings.TrimSuffix(p.filename, ext) + "." + profile + ext
+}
+
+// Load decodes the base file and then the overlay of selected profile into v. It returns ErrInvalidProfile
+// if profile name contains path separator and an error if the overlay file of selected profile does not exist.
+func (p *profileProvider) Load(ctx context.Context, v any, opts ...LoadOption) error {
+	if err := NewFileProvider(p.filename, p.decoder).Load(ctx, v, opts...); err != nil {
+		return err
+	}
+
+	profile := p.Profile()
+	if profile == "" {
+		return nil
+	}
+
+	if strings.ContainsAny(profile, `/\`) || profile == "." || profile == ".." {
+		return wrapErrInvalidProfile(profile)
+	}
+
+	return NewFileProvider(p.OverlayFilename(profile), p.decoder).Load(ctx, v, opts...)
+}
+
+// lookupArg returns value of flag with name from args. It supports "-name=value", "--name=value",
+// "-name value" and "--name value" forms and stops at "--" terminator.
+func lookupArg(args []string, name string) (string, bool) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			break
+		}
+
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+
+		arg = strings.TrimPrefix(strings.TrimPrefix(arg, "-"), "-")
+		if value, ok := strings.CutPrefix(arg, name+"="); ok {
+			return value, true
+		}
+
+		if arg == name && i+1 < len(args) {
+			return args[i+1], true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,120 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Prastiwar/Go-flow/config"
+	"github.com/Prastiwar/Go-flow/datas"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+type profileConfig struct {
+	Name     string `json:"name"`
+	Database struct {
+		Host string `json:"host"`
+		Port int    `json:"port"`
+	} `json:"database"`
+	Limits map[string]int `json:"limits"`
+}
+
+func writeProfileFiles(t *testing.T) string {
+	dir := t.TempDir()
+	files := map[string]string{
+		"config.json":         `{"name":"base","database":{"host":"localhost","port":5432},"limits":{"read":1,"write":1}}`,
+		"config.staging.json": `{"database":{"host":"staging-db"},"limits":{"write":5}}`,
+		"config.prod.json":    `{"name":"prod"}`,
+	}
+	for name, content := range files {
+		assert.NilError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	return filepath.Join(dir, "config.json")
+}
+
+func TestProfileProviderLoad(t *testing.T) {
+	filename := writeProfileFiles(t)
+
+	tests := []struct {
+		name    string
+		args    []string
+		env     string
+		opts    []config.ProfileOption
+		wantErr error
+		assert  func(t *testing.T, v profileConfig)
+	}{
+		{
+			name: "success-base-only",
+			opts: []config.ProfileOption{config.WithProfileEnv("PROFILE_TEST_ENV")},
+			assert: func(t *testing.T, v profileConfig) {
+				assert.Equal(t, "base", v.Name)
+				assert.Equal(t, "localhost", v.Database.Host)
+			},
+		},
+		{
+			name: "success-env-overlay-deep-merge",
+			env:  "staging",
+			opts: []config.ProfileOption{config.WithProfileEnv("PROFILE_TEST_ENV")},
+			assert: func(t *testing.T, v profileConfig) {
+				assert.Equal(t, "base", v.Name)
+				assert.Equal(t, "staging-db", v.Database.Host)
+				assert.Equal(t, 5432, v.Database.Port)
+				assert.MapMatch(t, map[string]int{"read": 1, "write": 5}, v.Limits)
+			},
+		},
+		{
+			name: "success-flag-over-env",
+			args: []string{"--profile", "prod"},
+			env:  "staging",
+			opts: []config.ProfileOption{
+				config.WithProfileEnv("PROFILE_TEST_ENV"),
+				config.WithProfileFlag("profile"),
+			},
+			assert: func(t *testing.T, v profileConfig) {
+				assert.Equal(t, "prod", v.Name)
+				assert.Equal(t, "localhost", v.Database.Host)
+			},
+		},
+		{
+			name: "success-default-profile",
+			opts: []config.ProfileOption{config.WithProfile("staging")},
+			assert: func(t *testing.T, v profileConfig) {
+				assert.Equal(t, "staging-db", v.Database.Host)
+			},
+		},
+		{
+			name:    "invalid-missing-overlay",
+			args:    []string{"-profile=missing"},
+			opts:    []config.ProfileOption{config.WithProfileFlag("profile")},
+			wantErr: os.ErrNotExist,
+		},
+		{
+			name:    "invalid-profile-path",
+			opts:    []config.ProfileOption{config.WithProfile("../config")},
+			wantErr: config.ErrInvalidProfile,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setArgs(tt.args...)
+			t.Setenv("PROFILE_TEST_ENV", tt.env)
+
+			var v profileConfig
+			err := config.NewProfileProvider(filename, datas.Json(), tt.opts...).Load(context.Background(), &v)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			tt.assert(t, v)
+		})
+	}
+}
+
+func TestProfileProviderOverlayFilename(t *testing.T) {
+	assert.Equal(t, "dir/config.staging.json", config.NewProfileProvider("dir/config.json", datas.Json()).OverlayFilename("staging"))
+	assert.Equal(t, "config.staging", config.NewProfileProvider("config", datas.Json()).OverlayFilename("staging"))
+}
@@ -1,9 +1,12 @@
 package config
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"os"
+	"reflect"
+	"sort"
 
 	"github.com/Prastiwar/Go-flow/datas"
 )
@@ -21,11 +24,113 @@ func NewReaderProvider(r io.Reader, d datas.ReaderUnmarshaler) *readerProvider {
 	}
 }
 
-// Load parses flag definitions from the argument list, which should not include the command name.
-// Parsed flag value results are stored in matching v fields. If there is no matching field it
-// will be ignored and it's value will not be overridden.
+// Load decodes content of the reader and stores it in v. If there is no matching field it
+// will be ignored and it's value will not be overridden. If LoadOptions.FieldSetHook is set, content is
+// decoded once again to generic map and each field with non-null key present in the document is reported as set
+// by ReaderProviderName provider. If the document cannot be decoded to map, each non-zero field is reported instead.
+// If LoadOptions.Strict is set, keys which do not match any field are returned as ErrUnknownKey errors.
+// Keys are matched with fields named by tags of decoder implementing datas.FieldTagger (e.g. `yaml` tag with
+// `json` fallback for datas.Yaml()) or by `json` tags otherwise.
 func (p *readerProvider) Load(ctx context.Context, v any, opts ...LoadOption) error {
-	return p.decoder.UnmarshalFrom(p.reader, v)
+	options := NewLoadOptions(opts...)
+	val, err := valueLoadOf(v)
+	if (options.FieldSetHook == nil && !options.Strict) || err != nil {
+		return p.decoder.UnmarshalFrom(p.reader, v)
+	}
+
+	data, err := io.ReadAll(p.reader)
+	if err != nil {
+		return err
+	}
+
+	// unknown keys are checked first, so v is not modified if document is invalid
+	if options.Strict {
+		if err := checkUnknownKeys(p.decoder, bytes.NewReader(data), val.Type()); err != nil {
+			return err
+		}
+	}
+
+	if err := p.decoder.UnmarshalFrom(bytes.NewReader(data), v); err != nil {
+		return err
+	}
+
+	if options.FieldSetHook == nil {
+		return nil
+	}
+
+	var doc map[string]any
+	if err := p.decoder.UnmarshalFrom(bytes.NewReader(data), &doc); err == nil {
+		notifyDocumentFields(options, val.Type(), fieldTags(p.decoder), doc, "")
+		return nil
+	}
+
+	decoded := reflect.New(val.Type())
+	if err := p.decoder.UnmarshalFrom(bytes.NewReader(data), decoded.Interface()); err != nil {
+		return err
+	}
+
+	notifyNonZeroFields(options, decoded.Elem(), "")
+	return nil
+}
+
+// notifyDocumentFields reports each t field matching non-null doc key as set by ReaderProviderName provider.
+// Keys are matched with fields named by tags and objects of nested struct fields are walked recursively.
+func notifyDocumentFields(options *LoadOptions, t reflect.Type, tags []string, doc map[string]any, path string) {
+	keys := make([]string, 0, len(doc))
+	for key := range doc {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := doc[key]
+		if value == nil {
+			continue
+		}
+
+		f, ok := fieldByKey(t, tags, key)
+		if !ok {
+			continue
+		}
+
+		fieldPath := joinPath(path, f.path)
+		fieldType := f.field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if obj, ok := value.(map[string]any); ok && isNestedStruct(fieldType) {
+			notifyDocumentFields(options, fieldType, tags, obj, fieldPath)
+			continue
+		}
+
+		options.notifyFieldSet(ReaderProviderName, fieldPath, fieldPath)
+	}
+}
+
+// notifyNonZeroFields reports each non-zero val field as set by ReaderProviderName provider.
+// Nested struct fields are walked recursively.
+func notifyNonZeroFields(options *LoadOptions, val reflect.Value, path string) {
+	valType := val.Type()
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Field(i)
+		sf := valType.Field(i)
+		if !sf.IsExported() || field.IsZero() {
+			continue
+		}
+
+		fieldPath := joinPath(path, sf.Name)
+		if isNestedStruct(field.Type()) {
+			notifyNonZeroFields(options, field, fieldPath)
+			continue
+		}
+		if field.Kind() == reflect.Pointer && isNestedStruct(field.Type().Elem()) {
+			notifyNonZeroFields(options, field.Elem(), fieldPath)
+			continue
+		}
+
+		options.notifyFieldSet(ReaderProviderName, fieldPath, fieldPath)
+	}
 }
 
 type fileReader struct {
@@ -50,10 +155,27 @@ func (r *fileReader) Read(p []byte) (n int, err error) {
 	return f.Read(p)
 }
 
-// NewReaderProvider returns a new file provider with specified filename and decoder.
-func NewFileProvider(filename string, decoder datas.ReaderUnmarshaler) *readerProvider {
-	return &readerProvider{
-		reader:  NewFileReader(filename),
-		decoder: decoder,
+type fileProvider struct {
+	filename string
+	decoder  datas.ReaderUnmarshaler
+}
+
+// NewFileProvider returns a new file provider with specified filename and decoder.
+// The file is opened and decoded from the beginning on each Load, so its latest content is always loaded.
+func NewFileProvider(filename string, decoder datas.ReaderUnmarshaler) *fileProvider {
+	return &fileProvider{
+		filename: filename,
+		decoder:  decoder,
+	}
+}
+
+// Load opens the file and decodes its content to v the same way as reader provider does.
+func (p *fileProvider) Load(ctx context.Context, v any, opts ...LoadOption) error {
+	f, err := os.Open(p.filename)
+	if err != nil {
+		return err
 	}
+	defer f.Close()
+
+	return NewReaderProvider(f, p.decoder).Load(ctx, v, opts...)
 }
@@ -45,6 +45,23 @@ func TestReaderProviderLoad(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "success-yaml-reader",
+			init: func(t *testing.T) (config.Provider, any, func()) {
+				provider := config.NewReaderProvider(strings.NewReader("title: header\nport: 8080\n"), datas.Yaml())
+
+				v := struct {
+					Title string
+					Port  int `json:"port"`
+				}{}
+
+				return provider, &v, func() {
+					assert.Equal(t, "header", v.Title)
+					assert.Equal(t, 8080, v.Port)
+				}
+			},
+			wantErr: false,
+		},
 		{
 			name: "success-file-reader",
 			init: func(t *testing.T) (config.Provider, any, func()) {
@@ -95,3 +112,29 @@ func TestReaderProviderLoad(t *testing.T) {
 		})
 	}
 }
+
+func TestSourceLoadYamlTaggedFields(t *testing.T) {
+	s := config.Provide(
+		config.NewReaderProvider(strings.NewReader("db_host: h\ndb:\n  max_conns: 5\n"), datas.Yaml()),
+	)
+
+	v := struct {
+		Host     string `yaml:"db_host" required:"true"`
+		Database struct {
+			MaxConns int `yaml:"max_conns"`
+		} `yaml:"db"`
+	}{}
+
+	var report config.LoadReport
+	err := s.Load(context.Background(), &v, config.WithReport(&report))
+
+	assert.NilError(t, err)
+	assert.Equal(t, "h", v.Host)
+	assert.Equal(t, 5, v.Database.MaxConns)
+
+	host, ok := report.Field("Host")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, config.ReaderProviderName, host.Provider)
+	_, ok = report.Field("Database.MaxConns")
+	assert.Equal(t, true, ok)
+}
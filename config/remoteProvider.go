@@ -0,0 +1,209 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/Prastiwar/Go-flow/datas"
+	"github.com/Prastiwar/Go-flow/httpf"
+)
+
+type RemoteOption func(*RemoteOptions)
+
+// RemoteOptions stores settings to control behaviour of remote provider.
+type RemoteOptions struct {
+	// CacheFile is a path where the last successfully fetched document is stored.
+	CacheFile string
+	// Header is sent with each request.
+	Header http.Header
+	// CacheErrorHandler is called with error of failed cache file write.
+	CacheErrorHandler func(err error)
+}
+
+func NewRemoteOptions(options ...RemoteOption) *RemoteOptions {
+	opts := &RemoteOptions{
+		Header: make(http.Header),
+	}
+	for _, o := range options {
+		o(opts)
+	}
+
+	return opts
+}
+
+// WithRemoteCache sets path of file where the last fetched document is stored. It's used if the endpoint
+// is unreachable before any document was fetched, e.g. when the service starts during endpoint outage.
+func WithRemoteCache(filename string) RemoteOption {
+	return func(o *RemoteOptions) {
+		o.CacheFile = filename
+	}
+}
+
+// WithRemoteCacheErrorHandler sets function called with error of failed cache file write. The cache is only
+// a fallback, so fetched document is still loaded and the write is retried on the next fetch.
+func WithRemoteCacheErrorHandler(fn func(err error)) RemoteOption {
+	return func(o *RemoteOptions) {
+		o.CacheErrorHandler = fn
+	}
+}
+
+// WithRemoteHeader adds header sent with each request, e.g. Authorization.
+func WithRemoteHeader(key, value string) RemoteOption {
+	return func(o *RemoteOptions) {
+		o.Header.Add(key, value)
+	}
+}
+
+type remoteProvider struct {
+	url     string
+	client  httpf.Client
+	decoder datas.ReaderUnmarshaler
+	options RemoteOptions
+
+	mu     sync.Mutex
+	etag   string
+	body   []byte
+	cached bool
+}
+
+// NewRemoteProvider returns a new provider fetching document from url with client and decoding it with decoder.
+// Requests are conditional - ETag of the last response is sent in If-None-Match header, so unchanged document
+// is not transferred again. If the endpoint is unreachable or responds with server error, the last fetched
+// document is used and if there is none, the copy stored in cache file set with WithRemoteCache is loaded.
+func NewRemoteProvider(url string, client httpf.Client, decoder datas.ReaderUnmarshaler, opts ...RemoteOption) *remoteProvider {
+	return &remoteProvider{
+		url:     url,
+		client:  client,
+		decoder: decoder,
+		options: *NewRemoteOptions(opts...),
+	}
+}
+
+// Fetch sends conditional request for the document and reports whether it has changed since the last fetch.
+// It can be called periodically to poll the endpoint and reload configuration only if it's changed.
+// ErrRemoteUnavailable is returned if the endpoint cannot be reached or responds with server error
+// and ErrRemoteStatus if it responds with status other than 200 or 304. Failed cache file write is not
+// returned, it's passed to CacheErrorHandler instead.
+func (p *remoteProvider) Fetch(ctx context.Context) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	req, err := http.NewRequest(http.MethodGet, p.url, nil)
+	if err != nil {
+		return false, err
+	}
+
+	for key, values := range p.options.Header {
+		req.Header[key] = values
+	}
+	if p.etag != "" {
+		req.Header.Set(httpf.IfNoneMatchHeader, p.etag)
+	}
+
+	resp, err := p.client.Send(ctx, req)
+	if err != nil {
+		return false, wrapErrRemoteUnavailable(p.url, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && p.body != nil:
+		return false, nil
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return false, wrapErrRemoteUnavailable(p.url, fmt.Errorf("status %v", resp.StatusCode))
+	case resp.StatusCode != http.StatusOK:
+		return false, wrapErrRemoteStatus(p.url, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, wrapErrRemoteUnavailable(p.url, err)
+	}
+
+	changed := !bytes.Equal(body, p.body)
+	p.body = body
+	p.etag = resp.Header.Get(httpf.ETagHeader)
+
+	if p.options.CacheFile != "" && (changed || !p.cached) {
+		err := writeCacheFile(p.options.CacheFile, body)
+		p.cached = err == nil
+		if err != nil && p.options.CacheErrorHandler != nil {
+			p.options.CacheErrorHandler(err)
+		}
+	}
+
+	return changed, nil
+}
+
+// Load fetches the document and decodes it into v. If the endpoint is unavailable, the last good copy is decoded.
+// Fields are reported as set by RemoteProviderName provider.
+func (p *remoteProvider) Load(ctx context.Context, v any, opts ...LoadOption) error {
+	if _, err := p.Fetch(ctx); err != nil {
+		if !errors.Is(err, ErrRemoteUnavailable) {
+			return err
+		}
+
+		if !p.restore() {
+			return err
+		}
+	}
+
+	p.mu.Lock()
+	body := p.body
+	p.mu.Unlock()
+
+	// document is decoded as by reader provider, but set fields are reported as loaded from remote endpoint
+	options := NewLoadOptions(opts...)
+	readerOptions := *options
+	readerOptions.FieldSetHook = func(providerName, path, key string) {
+		options.notifyFieldSet(RemoteProviderName, path, key)
+	}
+
+	return NewReaderProvider(bytes.NewReader(body), p.decoder).Load(ctx, v, func(o *LoadOptions) {
+		*o = readerOptions
+	})
+}
+
+// restore loads document from cache file if there is no fetched one. It reports whether any document is available.
+func (p *remoteProvider) restore() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.body != nil {
+		return true
+	}
+
+	if p.options.CacheFile == "" {
+		return false
+	}
+
+	body, err := os.ReadFile(p.options.CacheFile)
+	if err != nil {
+		return false
+	}
+
+	// etag is unknown, so the next fetch is unconditional
+	p.body = body
+	return true
+}
+
+// writeCacheFile replaces content of filename with body. It writes temporary file first, so the cache
+// is never left partially written.
+func writeCacheFile(filename string, body []byte) error {
+	tmp := filename + ".tmp"
+	if err := os.WriteFile(tmp, body, 0o600); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp, filename); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package config_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Prastiwar/Go-flow/config"
+	"github.com/Prastiwar/Go-flow/datas"
+	"github.com/Prastiwar/Go-flow/httpf"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+type remoteConfig struct {
+	Name string `json:"name"`
+	Port int    `json:"port"`
+}
+
+func TestRemoteProviderLoad(t *testing.T) {
+	var (
+		body          atomic.Value
+		requests      atomic.Int32
+		notModified   atomic.Int32
+		unavailable   atomic.Bool
+		authorization atomic.Value
+	)
+	body.Store(`{"name":"svc","port":8080}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		authorization.Store(r.Header.Get("Authorization"))
+		if unavailable.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		content := body.Load().(string)
+		etag := `"` + content + `"`
+		if r.Header.Get(httpf.IfNoneMatchHeader) == etag {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set(httpf.ETagHeader, etag)
+		_, _ = w.Write([]byte(content))
+	}))
+	defer server.Close()
+
+	cacheFile := filepath.Join(t.TempDir(), "remote.json")
+	p := config.NewRemoteProvider(server.URL, httpf.NewClient(), datas.Json(),
+		config.WithRemoteCache(cacheFile), config.WithRemoteHeader("Authorization", "Bearer token"))
+
+	var v remoteConfig
+	assert.NilError(t, p.Load(context.Background(), &v))
+	assert.Equal(t, remoteConfig{Name: "svc", Port: 8080}, v)
+	assert.Equal(t, "Bearer token", authorization.Load().(string))
+
+	cached, err := os.ReadFile(cacheFile)
+	assert.NilError(t, err)
+	assert.Equal(t, `{"name":"svc","port":8080}`, string(cached))
+
+	changed, err := p.Fetch(context.Background())
+	assert.NilError(t, err)
+	assert.Equal(t, false, changed)
+	assert.Equal(t, int32(1), notModified.Load())
+
+	var unchanged remoteConfig
+	assert.NilError(t, p.Load(context.Background(), &unchanged))
+	assert.Equal(t, remoteConfig{Name: "svc", Port: 8080}, unchanged)
+	assert.Equal(t, int32(2), notModified.Load())
+
+	body.Store(`{"name":"svc","port":9090}`)
+	changed, err = p.Fetch(context.Background())
+	assert.NilError(t, err)
+	assert.Equal(t, true, changed)
+
+	unavailable.Store(true)
+	var fallback remoteConfig
+	assert.NilError(t, p.Load(context.Background(), &fallback))
+	assert.Equal(t, remoteConfig{Name: "svc", Port: 9090}, fallback)
+
+	_, err = p.Fetch(context.Background())
+	assert.ErrorIs(t, err, config.ErrRemoteUnavailable)
+	assert.Equal(t, int32(6), requests.Load())
+}
+
+func TestRemoteProviderLoadCacheFallback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"cached","port":80}`))
+	}))
+	url := server.URL
+	server.Close()
+
+	cacheFile := filepath.Join(t.TempDir(), "remote.json")
+	assert.NilError(t, os.WriteFile(cacheFile, []byte(`{"name":"cached","port":80}`), 0o600))
+
+	p := config.NewRemoteProvider(url, httpf.NewClient(), datas.Json(), config.WithRemoteCache(cacheFile))
+
+	var v remoteConfig
+	assert.NilError(t, p.Load(context.Background(), &v))
+	assert.Equal(t, remoteConfig{Name: "cached", Port: 80}, v)
+}
+
+func TestRemoteProviderLoadCacheWriteError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"svc","port":80}`))
+	}))
+	defer server.Close()
+
+	cacheFile := filepath.Join(t.TempDir(), "missing", "remote.json")
+	handlerCounter := assert.Count(t, 2)
+	p := config.NewRemoteProvider(server.URL, httpf.NewClient(), datas.Json(),
+		config.WithRemoteCache(cacheFile),
+		config.WithRemoteCacheErrorHandler(func(err error) {
+			handlerCounter.Inc()
+			assert.ErrorIs(t, err, os.ErrNotExist)
+		}),
+	)
+
+	for i := 0; i < 2; i++ {
+		var v remoteConfig
+		assert.NilError(t, p.Load(context.Background(), &v))
+		assert.Equal(t, remoteConfig{Name: "svc", Port: 80}, v)
+	}
+	handlerCounter.Assert(t)
+}
+
+func TestRemoteProviderLoadFieldSetHook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"svc"}`))
+	}))
+	defer server.Close()
+
+	p := config.NewRemoteProvider(server.URL, httpf.NewClient(), datas.Json())
+
+	var reported []string
+	var v remoteConfig
+	err := p.Load(context.Background(), &v, config.WithFieldSetHook(func(providerName, path, key string) {
+		assert.Equal(t, config.RemoteProviderName, providerName)
+		reported = append(reported, path)
+	}))
+
+	assert.NilError(t, err)
+	assert.ElementsMatch(t, []string{"Name"}, reported)
+}
+
+func TestRemoteProviderLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		init    func(t *testing.T) string
+		wantErr error
+	}{
+		{
+			name: "unavailable-without-cache",
+			init: func(t *testing.T) string {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusBadGateway)
+				}))
+				t.Cleanup(server.Close)
+				return server.URL
+			},
+			wantErr: config.ErrRemoteUnavailable,
+		},
+		{
+			name: "not-found",
+			init: func(t *testing.T) string {
+				server := httptest.NewServer(http.NotFoundHandler())
+				t.Cleanup(server.Close)
+				return server.URL
+			},
+			wantErr: config.ErrRemoteStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := tt.init(t)
+			p := config.NewRemoteProvider(url, httpf.NewClient(), datas.Json(),
+				config.WithRemoteCache(filepath.Join(t.TempDir(), "missing.json")))
+
+			var v remoteConfig
+			err := p.Load(context.Background(), &v)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, remoteConfig{}, v)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const redactedValue = "******"
+
+// ProvidedValue is a value set to field by named provider.
+type ProvidedValue struct {
+	Provider string
+	Value    any
+}
+
+// FieldReport describes where the final value of field came from. Value is the final value of the field with
+// resolved interpolation expressions. Overridden contains values set by previous providers in the order they
+// were set. Field is secret if it's tagged with `secret:"true"`, any of its values was set by secrets provider
+// or its value was interpolated from secret field.
+// Secret field values are redacted when the report is built, so they're not exposed by printing or marshaling.
+type FieldReport struct {
+	Path       string
+	Provider   string
+	Value      any
+	Overridden []ProvidedValue
+	Secret     bool
+}
+
+// String returns human-readable provenance of the field, e.g. "Port=8080 (flag, overrode: default=80, env=8000)".
+func (r FieldReport) String() string {
+	var b strings.Builder
+	b.WriteString(r.Path)
+	b.WriteString("=")
+	b.WriteString(r.format(r.Value))
+	b.WriteString(" (")
+	b.WriteString(r.Provider)
+	if len(r.Overridden) > 0 {
+		b.WriteString(", overrode: ")
+		for i, o := range r.Overridden {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(o.Provider)
+			b.WriteString("=")
+			b.WriteString(r.format(o.Value))
+		}
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (r FieldReport) format(v any) string {
+	if r.Secret {
+		return redactedValue
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// redact replaces Value and overridden values with redacted placeholder.
+func (r *FieldReport) redact() {
+	r.Value = redactedValue
+	overridden := make([]ProvidedValue, len(r.Overridden))
+	for i, o := range r.Overridden {
+		overridden[i] = ProvidedValue{Provider: o.Provider, Value: redactedValue}
+	}
+	r.Overridden = overridden
+}
+
+// LoadReport records provenance of each field set during Source loading. Fields are sorted by path.
+// Use WithReport option to collect it.
+type LoadReport struct {
+	Fields []FieldReport
+}
+
+// Field returns report of field with specified dot-separated path of struct field names.
+func (r *LoadReport) Field(path string) (FieldReport, bool) {
+	for _, f := range r.Fields {
+		if f.Path == path {
+			return f, true
+		}
+	}
+	return FieldReport{}, false
+}
+
+// String returns each field report in separate line. Values of secret fields are redacted.
+func (r *LoadReport) String() string {
+	lines := make([]string, len(r.Fields))
+	for i, f := range r.Fields {
+		lines[i] = f.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// reportTracker collects values set to fields of loaded struct.
+type reportTracker struct {
+	val     reflect.Value
+	history map[string][]ProvidedValue
+	secrets map[string]struct{}
+}
+
+func newReportTracker(val reflect.Value) *reportTracker {
+	return &reportTracker{
+		val:     val,
+		history: make(map[string][]ProvidedValue),
+		secrets: make(map[string]struct{}),
+	}
+}
+
+// markSecret marks field at path as secret, e.g. because its value was interpolated from secret field.
+func (t *reportTracker) markSecret(path string) {
+	t.secrets[path] = struct{}{}
+}
+
+// record stores current value of field at path as set by provider.
+func (t *reportTracker) record(providerName, path string) {
+	field, ok := fieldByPath(t.val, path)
+	if !ok {
+		return
+	}
+
+	t.history[path] = append(t.history[path], ProvidedValue{
+		Provider: providerName,
+		Value:    field.Interface(),
+	})
+}
+
+// recordChanged stores values of leaf fields which are different than in before snapshot as set by provider.
+func (t *reportTracker) recordChanged(providerName string, before map[string]any) {
+	after := make(map[string]any)
+	leafValues(t.val, "", after)
+
+	for path, v := range after {
+		prev, ok := before[path]
+		if !ok && v != nil {
+			// field of allocated pointer-to-struct is changed only if it's not zero
+			prev = reflect.Zero(reflect.TypeOf(v)).Interface()
+		}
+
+		if !reflect.DeepEqual(prev, v) {
+			t.history[path] = append(t.history[path], ProvidedValue{Provider: providerName, Value: v})
+		}
+	}
+}
+
+// report returns LoadReport built from recorded values.
+func (t *reportTracker) report() LoadReport {
+	fields := make([]FieldReport, 0, len(t.history))
+	for path, values := range t.history {
+		last := values[len(values)-1]
+
+		// value recorded by provider can be changed by interpolation afterwards
+		value := last.Value
+		if field, ok := fieldByPath(t.val, path); ok {
+			value = field.Interface()
+		}
+
+		field := FieldReport{
+			Path:       path,
+			Provider:   last.Provider,
+			Value:      value,
+			Overridden: values[:len(values)-1],
+			Secret:     t.isSecret(path, values),
+		}
+		if field.Secret {
+			field.redact()
+		}
+		fields = append(fields, field)
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Path < fields[j].Path
+	})
+
+	return LoadReport{Fields: fields}
+}
+
+// isSecret reports whether field at path with recorded values is secret.
+func (t *reportTracker) isSecret(path string, values []ProvidedValue) bool {
+	if _, ok := t.secrets[path]; ok {
+		return true
+	}
+	return isSecretField(t.val.Type(), path) || isProvidedBySecrets(values)
+}
+
+// leafValues stores values of val fields which are not nested structs in out map by their path.
+// Nested struct fields and non-nil pointer-to-struct fields are walked recursively.
+func leafValues(val reflect.Value, path string, out map[string]any) {
+	valType := val.Type()
+	for i := 0; i < val.NumField(); i++ {
+		sf := valType.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		field := val.Field(i)
+		fieldPath := joinPath(path, sf.Name)
+		switch {
+		case isNestedStruct(field.Type()):
+			leafValues(field, fieldPath, out)
+		case field.Kind() == reflect.Pointer && isNestedStruct(field.Type().Elem()):
+			if !field.IsNil() {
+				leafValues(field.Elem(), fieldPath, out)
+			}
+		default:
+			out[fieldPath] = field.Interface()
+		}
+	}
+}
+
+// fieldByPath returns val field found by dot-separated path of struct field names. Pointers are dereferenced.
+func fieldByPath(val reflect.Value, path string) (reflect.Value, bool) {
+	for _, name := range strings.Split(path, ".") {
+		for val.Kind() == reflect.Pointer {
+			if val.IsNil() {
+				return reflect.Value{}, false
+			}
+			val = val.Elem()
+		}
+
+		if val.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+
+		val = val.FieldByName(name)
+		if !val.IsValid() {
+			return reflect.Value{}, false
+		}
+	}
+	return val, true
+}
+
+// isProvidedBySecrets reports whether any of values was set by secrets provider.
+func isProvidedBySecrets(values []ProvidedValue) bool {
+	for _, v := range values {
+		if v.Provider == SecretsProviderName {
+			return true
+		}
+	}
+	return false
+}
+
+// isSecretField reports whether field at path of t struct or any of its parent fields is tagged with `secret:"true"`.
+func isSecretField(t reflect.Type, path string) bool {
+	for _, name := range strings.Split(path, ".") {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+
+		if t.Kind() != reflect.Struct {
+			return false
+		}
+
+		sf, ok := t.FieldByName(name)
+		if !ok {
+			return false
+		}
+		if sf.Tag.Get(SecretTag) == "true" {
+			return true
+		}
+		t = sf.Type
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package config_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Prastiwar/Go-flow/config"
+	"github.com/Prastiwar/Go-flow/datas"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+func TestSourceLoadReport(t *testing.T) {
+	s := config.Provide(
+		config.NewReaderProvider(strings.NewReader(`{"Port":8000,"Password":"from-file"}`), datas.Json()),
+		config.NewEnvProvider(),
+		config.NewFlagProvider(
+			config.Int32Flag("Port", "port"),
+		),
+	)
+	assert.NilError(t, s.SetDefault(config.Opt("Name", "service")))
+
+	t.Setenv("REPORT_PASSWORD", "from-env")
+	t.Setenv("REPORT_HOST", "db")
+	setArgs("-Port=9000")
+
+	v := struct {
+		Name     string
+		Port     int    `default:"80"`
+		Password string `env:"REPORT_PASSWORD" secret:"true"`
+		Unset    string
+		Database *struct {
+			Host string `env:"HOST"`
+		} `env:"REPORT"`
+	}{}
+
+	var report config.LoadReport
+	err := s.Load(context.Background(), &v, config.WithReport(&report))
+	assert.NilError(t, err)
+
+	assert.Equal(t, 9000, v.Port)
+	assert.Equal(t, 4, len(report.Fields))
+
+	port, ok := report.Field("Port")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, config.FlagProviderName, port.Provider)
+	assert.Equal(t, 9000, port.Value)
+	assert.ElementsMatch(t, []config.ProvidedValue{
+		{Provider: config.DefaultProviderName, Value: 80},
+		{Provider: config.ReaderProviderName, Value: 8000},
+	}, port.Overridden)
+
+	name, _ := report.Field("Name")
+	assert.Equal(t, config.DefaultProviderName, name.Provider)
+	assert.Equal(t, "Name=service (default)", name.String())
+
+	host, _ := report.Field("Database.Host")
+	assert.Equal(t, config.EnvProviderName, host.Provider)
+
+	_, ok = report.Field("Unset")
+	assert.Equal(t, false, ok)
+
+	password, _ := report.Field("Password")
+	assert.Equal(t, true, password.Secret)
+	assert.Equal(t, "******", password.Value)
+
+	marshaled, err := json.Marshal(report)
+	assert.NilError(t, err)
+	assert.Equal(t, false, strings.Contains(string(marshaled), "from-env"))
+	assert.Equal(t, false, strings.Contains(string(marshaled), "from-file"))
+
+	printed := report.String()
+	assert.Equal(t, strings.Join([]string{
+		"Database.Host=db (env)",
+		"Name=service (default)",
+		"Password=****** (env, overrode: reader=******)",
+		"Port=9000 (flag, overrode: default=80, reader=8000)",
+	}, "\n"), printed)
+}
+
+func TestSourceLoadWithoutReport(t *testing.T) {
+	s := config.Provide(config.NewReaderProvider(strings.NewReader(`{"Port":8000}`), datas.Json()))
+
+	v := struct {
+		Port int
+	}{}
+
+	var report config.LoadReport
+	err := s.Load(context.Background(), &v)
+
+	assert.NilError(t, err)
+	assert.Equal(t, 8000, v.Port)
+	assert.Equal(t, 0, len(report.Fields))
+}
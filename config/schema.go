@@ -0,0 +1,344 @@
+package config
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/datas"
+	"github.com/Prastiwar/Go-flow/reflection"
+)
+
+// JsonSchemaVersion is the JSON Schema dialect of documents returned by JsonSchema.
+const JsonSchemaVersion = "https://json-schema.org/draft/2020-12/schema"
+
+// JsonSchema returns JSON Schema document describing JSON configuration file which can be loaded into v, so files
+// can be validated in CI or editors. Property names are keys matched by file decoding - `json` tag name or field name,
+// and fields of embedded structs are promoted as in JSON decoding. Use JsonSchemaFor for files in other formats. Fields tagged with `required:"true"` are listed
+// as required, `default` tag or non-zero v field value is used as default, `usage` tag as description and `validate`
+// tag rules are mapped to matching keywords. Objects do not allow additional properties as in strict loading mode.
+// Recursive struct types are defined in $defs and referenced with $ref. Default values are parsed with separators
+// set in opts. The result can be encoded with any JSON encoder.
+func JsonSchema(v any, opts ...LoadOption) (map[string]any, error) {
+	return JsonSchemaFor(v, datas.Json(), opts...)
+}
+
+// JsonSchemaFor returns JSON Schema document as JsonSchema does, but property names are keys matched by decoder
+// (see NewReaderProvider), e.g. `yaml` tag names with `json` fallback for datas.Yaml(). It allows to validate
+// YAML configuration files with JSON Schema.
+func JsonSchemaFor(v any, decoder datas.ReaderUnmarshaler, opts ...LoadOption) (map[string]any, error) {
+	val, err := valueLoadOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	options := NewLoadOptions(opts...)
+	b := &schemaBuilder{
+		opts:  options.parseOptions(),
+		tags:  fieldTags(decoder),
+		defs:  make(map[string]any),
+		names: make(map[reflect.Type]string),
+	}
+
+	schema, err := b.structSchema(val.Type(), val, "")
+	if err != nil {
+		return nil, err
+	}
+
+	schema["$schema"] = JsonSchemaVersion
+	if len(b.defs) > 0 {
+		schema["$defs"] = b.defs
+	}
+	return schema, nil
+}
+
+// schemaBuilder builds schema of struct types. Struct type which is nested in itself is defined once
+// in $defs and referenced with $ref.
+type schemaBuilder struct {
+	opts     []reflection.ParseOption
+	tags     []string
+	building []reflect.Type
+	defs     map[string]any
+	names    map[reflect.Type]string
+}
+
+// structSchema returns object schema of t struct. val is the current value of the struct or invalid value if it's nil.
+// If t is already being built, reference to its definition is returned instead.
+func (b *schemaBuilder) structSchema(t reflect.Type, val reflect.Value, path string) (map[string]any, error) {
+	for i, building := range b.building {
+		if building == t {
+			return b.ref(t, i == 0), nil
+		}
+	}
+
+	b.building = append(b.building, t)
+	defer func() {
+		b.building = b.building[:len(b.building)-1]
+	}()
+
+	properties := make(map[string]any)
+	var required []string
+
+	for _, f := range documentFields(t, b.tags) {
+		fieldPath := joinPath(path, f.path)
+		var field reflect.Value
+		if val.IsValid() {
+			// field promoted from nil embedded pointer or unexported embedded struct has no readable value
+			if v, err := val.FieldByIndexErr(f.field.Index); err == nil && v.CanInterface() {
+				field = v
+			}
+		}
+
+		schema, err := b.fieldSchema(f.field, field, fieldPath)
+		if err != nil {
+			return nil, err
+		}
+
+		properties[f.key] = schema
+		if f.field.Tag.Get(RequiredTag) == "true" {
+			required = append(required, f.key)
+		}
+	}
+
+	schema := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	// type referenced by its own fields is moved to definitions, root document is referenced directly
+	if name, ok := b.names[t]; ok && len(b.building) > 1 {
+		b.defs[name] = schema
+		return b.ref(t, false), nil
+	}
+
+	return schema, nil
+}
+
+// ref returns schema referencing definition of t type or the root document if root is true.
+func (b *schemaBuilder) ref(t reflect.Type, root bool) map[string]any {
+	if root {
+		return map[string]any{"$ref": "#"}
+	}
+
+	name, ok := b.names[t]
+	if !ok {
+		name = b.defName(t)
+		b.names[t] = name
+	}
+	return map[string]any{"$ref": "#/$defs/" + name}
+}
+
+// defName returns unique name of t type definition.
+func (b *schemaBuilder) defName(t reflect.Type) string {
+	base := t.Name()
+	if base == "" {
+		base = "Struct"
+	}
+
+	name := base
+	for n := 2; ; n++ {
+		taken := false
+		for _, used := range b.names {
+			if used == name {
+				taken = true
+				break
+			}
+		}
+		if !taken {
+			return name
+		}
+		name = base + strconv.Itoa(n)
+	}
+}
+
+// fieldSchema returns schema of sf field with its default value, description and validation keywords.
+func (b *schemaBuilder) fieldSchema(sf reflect.StructField, field reflect.Value, path string) (map[string]any, error) {
+	fieldType := sf.Type
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+		if field.IsValid() {
+			if field.IsNil() {
+				field = reflect.Value{}
+			} else {
+				field = field.Elem()
+			}
+		}
+	}
+
+	if isNestedStruct(fieldType) {
+		schema, err := b.structSchema(fieldType, field, path)
+		if err != nil {
+			return nil, err
+		}
+		addDescription(schema, sf)
+		return schema, nil
+	}
+
+	schema := b.typeSchema(fieldType)
+	addDescription(schema, sf)
+
+	if def, ok := sf.Tag.Lookup(DefaultTag); ok {
+		value, err := reflection.GetFieldValueFor(fieldType, def, b.opts...)
+		if err != nil {
+			return nil, wrapErrInvalidDefault(path, err)
+		}
+		schema["default"] = schemaValue(value)
+	} else if field.IsValid() && !field.IsZero() {
+		schema["default"] = schemaValue(field)
+	}
+
+	rules, err := parseRules(sf.Tag.Get(ValidateTag))
+	if err != nil {
+		return nil, fmt.Errorf("field '%v': %w", path, err)
+	}
+	for _, rule := range rules {
+		addRuleKeyword(schema, fieldType, rule)
+	}
+
+	return schema, nil
+}
+
+// typeSchema returns schema of value of t type as it's encoded in JSON.
+func (b *schemaBuilder) typeSchema(t reflect.Type) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == reflect.TypeOf(time.Time{}) {
+		return map[string]any{"type": "string", "format": "date-time"}
+	}
+
+	if reflection.IsTextType(t) {
+		return map[string]any{"type": "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// byte slices are encoded as base64 string
+			return map[string]any{"type": "string"}
+		}
+		return map[string]any{"type": "array", "items": b.elemSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": b.elemSchema(t.Elem())}
+	}
+
+	// interface and other values are not restricted
+	return map[string]any{}
+}
+
+// elemSchema returns schema of slice or map element of t type.
+func (b *schemaBuilder) elemSchema(t reflect.Type) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if isNestedStruct(t) {
+		schema, err := b.structSchema(t, reflect.Value{}, "")
+		if err == nil {
+			return schema
+		}
+	}
+	return b.typeSchema(t)
+}
+
+// addDescription sets description from `usage` tag of sf.
+func addDescription(schema map[string]any, sf reflect.StructField) {
+	if usage := sf.Tag.Get(UsageTag); usage != "" {
+		schema["description"] = usage
+	}
+}
+
+// addRuleKeyword sets JSON Schema keyword matching validation rule of t type field.
+func addRuleKeyword(schema map[string]any, t reflect.Type, rule validationRule) {
+	switch rule.name {
+	case "min", "max":
+		limit := rangeLimit(t, rule.param)
+		if limit == nil {
+			return
+		}
+
+		keyword := map[string]string{"min": "minimum", "max": "maximum"}[rule.name]
+		switch t.Kind() {
+		case reflect.String:
+			keyword = rule.name + "Length"
+		case reflect.Slice, reflect.Array:
+			keyword = rule.name + "Items"
+		case reflect.Map:
+			keyword = rule.name + "Properties"
+		}
+		schema[keyword] = limit
+	case "oneof":
+		var enum []any
+		for _, s := range strings.Fields(rule.param) {
+			value, err := reflection.GetFieldValueFor(t, s)
+			if err != nil {
+				return
+			}
+			enum = append(enum, schemaValue(value))
+		}
+		schema["enum"] = enum
+	case "url":
+		schema["format"] = "uri"
+	case "regex":
+		schema["pattern"] = rule.param
+	}
+}
+
+// rangeLimit returns min or max rule limit of t type field as it's encoded in JSON or nil if it cannot be parsed.
+func rangeLimit(t reflect.Type, param string) any {
+	if t == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(param)
+		if err != nil {
+			return nil
+		}
+		return int64(d)
+	}
+
+	limit, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return limit
+	}
+	return int64(limit)
+}
+
+// schemaValue returns value as it's encoded in JSON document. Text types are encoded as string.
+func schemaValue(value reflect.Value) any {
+	if !reflection.IsTextType(value.Type()) {
+		return value.Interface()
+	}
+
+	// pointer method set contains methods with value receiver too
+	ptr := reflect.New(value.Type())
+	ptr.Elem().Set(value)
+	switch v := ptr.Interface().(type) {
+	case encoding.TextMarshaler:
+		if b, err := v.MarshalText(); err == nil {
+			return string(b)
+		}
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprint(value.Interface())
+}
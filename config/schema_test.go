@@ -0,0 +1,193 @@
+package config_test
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/config"
+	"github.com/Prastiwar/Go-flow/datas"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+func TestJsonSchema(t *testing.T) {
+	type Database struct {
+		Host     string        `json:"host" required:"true" usage:"database host"`
+		Port     int           `json:"port" default:"5432" validate:"min=1,max=65535"`
+		Timeout  time.Duration `json:"timeout" validate:"min=1s"`
+		Password string        `json:"-"`
+	}
+
+	v := struct {
+		Name     string  `json:"name" validate:"regex=^[a-z]+$,"`
+		Mode     string  `validate:"oneof=dev prod"`
+		Ratio    float64 `validate:"max=1"`
+		Enabled  bool
+		Tags     []string          `validate:"max=3"`
+		Labels   map[string]string `validate:"min=1"`
+		Endpoint url.URL           `validate:"url"`
+		Started  time.Time
+		Database *Database `required:"true"`
+		Replicas []Database
+		Extra    any
+		internal string
+	}{
+		Name:     "app",
+		Endpoint: url.URL{Scheme: "https", Host: "example.com"},
+	}
+
+	schema, err := config.JsonSchema(&v)
+	assert.NilError(t, err)
+
+	// compare encoded document, so the test does not depend on Go types inside the map
+	actual, err := json.Marshal(schema)
+	assert.NilError(t, err)
+
+	database := `{"additionalProperties":false,"properties":{` +
+		`"host":{"description":"database host","type":"string"},` +
+		`"port":{"default":5432,"maximum":65535,"minimum":1,"type":"integer"},` +
+		`"timeout":{"minimum":1000000000,"type":"integer"}},` +
+		`"required":["host"],"type":"object"}`
+
+	expected := `{"$schema":"https://json-schema.org/draft/2020-12/schema","additionalProperties":false,"properties":{` +
+		`"Database":` + database + `,` +
+		`"Enabled":{"type":"boolean"},` +
+		`"Endpoint":{"default":"https://example.com","format":"uri","type":"string"},` +
+		`"Extra":{},` +
+		`"Labels":{"additionalProperties":{"type":"string"},"minProperties":1,"type":"object"},` +
+		`"Mode":{"enum":["dev","prod"],"type":"string"},` +
+		`"Ratio":{"maximum":1,"type":"number"},` +
+		`"Replicas":{"items":` + database + `,"type":"array"},` +
+		`"Started":{"format":"date-time","type":"string"},` +
+		`"Tags":{"items":{"type":"string"},"maxItems":3,"type":"array"},` +
+		`"name":{"default":"app","pattern":"^[a-z]+$,","type":"string"}},` +
+		`"required":["Database"],"type":"object"}`
+
+	assert.Equal(t, expected, string(actual))
+}
+
+type schemaBase struct {
+	Host string `required:"true"`
+	Port int    `json:"port"`
+}
+
+type schemaNode struct {
+	Name     string       `json:"name"`
+	Next     *schemaNode  `json:"next"`
+	Children []schemaTree `json:"children"`
+}
+
+type schemaTree struct {
+	Value    int          `json:"value"`
+	Children []schemaTree `json:"children" usage:"child trees"`
+}
+
+func TestJsonSchemaEmbedded(t *testing.T) {
+	v := struct {
+		schemaBase
+		Port  int `json:"port" default:"80"`
+		Extra struct {
+			*schemaBase
+		}
+	}{}
+
+	schema, err := config.JsonSchema(&v)
+	assert.NilError(t, err)
+
+	actual, err := json.Marshal(schema)
+	assert.NilError(t, err)
+
+	expected := `{"$schema":"https://json-schema.org/draft/2020-12/schema","additionalProperties":false,"properties":{` +
+		`"Extra":{"additionalProperties":false,"properties":{` +
+		`"Host":{"type":"string"},"port":{"type":"integer"}},"required":["Host"],"type":"object"},` +
+		`"Host":{"type":"string"},` +
+		`"port":{"default":80,"type":"integer"}},` +
+		`"required":["Host"],"type":"object"}`
+
+	assert.Equal(t, expected, string(actual))
+}
+
+func TestJsonSchemaRecursive(t *testing.T) {
+	schema, err := config.JsonSchema(&schemaNode{})
+	assert.NilError(t, err)
+
+	actual, err := json.Marshal(schema)
+	assert.NilError(t, err)
+
+	expected := `{"$defs":{"schemaTree":{"additionalProperties":false,"properties":{` +
+		`"children":{"description":"child trees","items":{"$ref":"#/$defs/schemaTree"},"type":"array"},` +
+		`"value":{"type":"integer"}},"type":"object"}},` +
+		`"$schema":"https://json-schema.org/draft/2020-12/schema","additionalProperties":false,"properties":{` +
+		`"children":{"items":{"$ref":"#/$defs/schemaTree"},"type":"array"},` +
+		`"name":{"type":"string"},` +
+		`"next":{"$ref":"#"}},` +
+		`"type":"object"}`
+
+	assert.Equal(t, expected, string(actual))
+}
+
+func TestJsonSchemaForYaml(t *testing.T) {
+	v := struct {
+		Host     string `yaml:"db_host" required:"true"`
+		Port     int    `json:"port"`
+		Database struct {
+			MaxConns int `yaml:"max_conns"`
+		} `yaml:"db"`
+	}{}
+
+	schema, err := config.JsonSchemaFor(&v, datas.Yaml())
+	assert.NilError(t, err)
+
+	actual, err := json.Marshal(schema)
+	assert.NilError(t, err)
+
+	expected := `{"$schema":"https://json-schema.org/draft/2020-12/schema","additionalProperties":false,"properties":{` +
+		`"db":{"additionalProperties":false,"properties":{"max_conns":{"type":"integer"}},"type":"object"},` +
+		`"db_host":{"type":"string"},` +
+		`"port":{"type":"integer"}},` +
+		`"required":["db_host"],"type":"object"}`
+
+	assert.Equal(t, expected, string(actual))
+}
+
+func TestJsonSchemaErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       any
+		wantErr func(t *testing.T, err error)
+	}{
+		{
+			name: "invalid-non-pointer",
+			v:    struct{}{},
+			wantErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, config.ErrNonPointer)
+			},
+		},
+		{
+			name: "invalid-rule",
+			v: &struct {
+				Port int `validate:"positive"`
+			}{},
+			wantErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, config.ErrInvalidRule)
+			},
+		},
+		{
+			name: "invalid-default",
+			v: &struct {
+				Port int `default:"not-a-number"`
+			}{},
+			wantErr: func(t *testing.T, err error) {
+				assert.ErrorWith(t, err, "invalid default value for field 'Port'")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := config.JsonSchema(tt.v)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileEnvSuffix is appended to environment variable name to point to file containing its value, e.g. DB_PASSWORD_FILE.
+const FileEnvSuffix = "_FILE"
+
+type SecretsOption func(*SecretsOptions)
+
+// SecretsOptions stores settings to control behaviour of secrets provider.
+type SecretsOptions struct {
+	// EnvPrefix is prepended to environment variable names checked for FileEnvSuffix indirection.
+	EnvPrefix string
+}
+
+func NewSecretsOptions(options ...SecretsOption) *SecretsOptions {
+	opts := &SecretsOptions{}
+	for _, o := range options {
+		o(opts)
+	}
+
+	return opts
+}
+
+// WithSecretsEnvPrefix sets prefix of environment variables checked for FileEnvSuffix indirection. It should be
+// the same as prefix of environment provider, so APP_DB_PASSWORD_FILE can replace APP_DB_PASSWORD.
+func WithSecretsEnvPrefix(prefix string) SecretsOption {
+	return func(o *SecretsOptions) {
+		o.EnvPrefix = prefix
+	}
+}
+
+type secretsProvider struct {
+	dir     string
+	options SecretsOptions
+}
+
+// NewSecretsProvider returns a new provider reading values from files in dir with one file per key, the way
+// container platforms mount secrets (e.g. /run/secrets/db_password). Keys are intercepted with SecretsProviderName
+// and nested fields are joined with EnvKeySeparator. Missing files are skipped, so dir does not need to exist.
+// Additionally, if environment variable with FileEnvSuffix (e.g. DB_PASSWORD_FILE) is set for env key of field,
+// the value is read from file at its path and it takes precedence over dir. Trailing newlines are trimmed.
+// Each field set by this provider is reported as secret in LoadReport.
+func NewSecretsProvider(dir string, opts ...SecretsOption) *secretsProvider {
+	return &secretsProvider{
+		dir:     dir,
+		options: *NewSecretsOptions(opts...),
+	}
+}
+
+// Load reads secret files for each v field and stores their content in matching v fields. If there is no
+// matching file it will be ignored and field value will not be overridden.
+func (p *secretsProvider) Load(ctx context.Context, v any, opts ...LoadOption) error {
+	options := NewLoadOptions(opts...)
+
+	if p.dir != "" {
+		setter := NewFieldSetter(SecretsProviderName, *options)
+		err := setter.SetFields(v, func(key string) (any, error) {
+			return readSecretFile(filepath.Join(p.dir, key), true)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// env keys are intercepted as for env provider, but set fields are still reported as secrets
+	envOptions := *options
+	envOptions.FieldSetHook = func(providerName, path, key string) {
+		options.notifyFieldSet(SecretsProviderName, path, key)
+	}
+
+	setter := NewFieldSetter(EnvProviderName, envOptions)
+	return setter.SetFields(v, func(key string) (any, error) {
+		filename, ok := os.LookupEnv(p.options.EnvPrefix + key + FileEnvSuffix)
+		if !ok {
+			return nil, nil
+		}
+
+		return readSecretFile(filename, false)
+	})
+}
+
+// readSecretFile returns content of file with trimmed trailing newlines. If skipMissing is true and the file
+// does not exist, nil is returned.
+func readSecretFile(filename string, skipMissing bool) (any, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		if skipMissing && errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
@@ -0,0 +1,88 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Prastiwar/Go-flow/config"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+func writeSecret(t *testing.T, filename string, content string) {
+	t.Helper()
+	assert.NilError(t, os.WriteFile(filename, []byte(content), 0o600))
+}
+
+func TestSecretsProviderLoad(t *testing.T) {
+	checkEnvironment(t)
+
+	dir := t.TempDir()
+	writeSecret(t, filepath.Join(dir, "api_key"), "dir-key\n")
+	writeSecret(t, filepath.Join(dir, "database_password"), "dir-password\r\n")
+	writeSecret(t, filepath.Join(dir, "token"), "dir-token")
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	writeSecret(t, tokenFile, "file-token\n\n")
+	t.Setenv("APP_TOKEN_FILE", tokenFile)
+
+	v := struct {
+		APIKey   string `secrets:"api_key"`
+		Token    string
+		Missing  string
+		Database struct {
+			Password string
+		}
+	}{Missing: "unchanged"}
+
+	p := config.NewSecretsProvider(dir, config.WithSecretsEnvPrefix("APP_"))
+	err := p.Load(context.Background(), &v, config.WithInterceptor(func(providerName string, field reflect.StructField) string {
+		if providerName == config.EnvProviderName {
+			return strings.ToUpper(field.Name)
+		}
+		return strings.ToLower(field.Name)
+	}))
+
+	assert.NilError(t, err)
+	assert.Equal(t, "dir-key", v.APIKey)
+	assert.Equal(t, "file-token", v.Token)
+	assert.Equal(t, "unchanged", v.Missing)
+	assert.Equal(t, "dir-password", v.Database.Password)
+}
+
+func TestSecretsProviderLoadErrors(t *testing.T) {
+	checkEnvironment(t)
+
+	v := struct {
+		Token string
+	}{}
+
+	t.Setenv("Token_FILE", filepath.Join(t.TempDir(), "missing"))
+
+	err := config.NewSecretsProvider(filepath.Join(t.TempDir(), "missing-dir")).Load(context.Background(), &v)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestSecretsProviderReport(t *testing.T) {
+	dir := t.TempDir()
+	writeSecret(t, filepath.Join(dir, "Password"), "secret-value\n")
+
+	s := config.Provide(config.NewSecretsProvider(dir))
+	assert.NilError(t, s.SetDefault(config.Opt("Password", "default-value")))
+
+	v := struct {
+		Password string
+	}{}
+
+	var report config.LoadReport
+	assert.NilError(t, s.Load(context.Background(), &v, config.WithReport(&report)))
+
+	assert.Equal(t, "secret-value", v.Password)
+	f, ok := report.Field("Password")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, true, f.Secret)
+	assert.Equal(t, "Password=****** (secrets, overrode: default=******)", report.String())
+}
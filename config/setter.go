@@ -2,6 +2,7 @@ package config
 
 import (
 	"reflect"
+	"strings"
 
 	"github.com/Prastiwar/Go-flow/reflection"
 )
@@ -32,41 +33,165 @@ func NewFieldSetter(name string, o LoadOptions) *fieldSetter {
 // skip assignment. To override value and set nil value, FieldValueFinder should return reflect.ValueOf(nil).
 // If field type and found value are not the same type but found value is convertible, it will try to
 // convert it to matching type. It also supports converting between pointers and non-pointers.
+// String values are parsed to slices, maps and encoding.TextUnmarshaler types with separators from LoadOptions.
+// Nested struct and pointer-to-struct fields for which no value was found are walked recursively.
+// Their key is a path of intercepted field names joined with separator specific to the setter name
+// (see KeySeparator), e.g. "Database_Pool_MaxSize" for env or "Database.Pool.MaxSize" for flag.
+// Nil pointer-to-struct is allocated only if at least one of its nested fields was found. Struct type which
+// is already walked on the current path is not walked again, so self-referential types are supported.
+// Every set field is reported to LoadOptions.FieldSetHook if it's configured.
 func (s *fieldSetter) SetFields(v any, findFn FieldValueFinder) error {
 	toVal, err := valueLoadOf(v)
 	if err != nil {
 		return err
 	}
 
-	count := toVal.NumField()
-	toValType := toVal.Type()
+	_, err = s.setStruct(toVal, fieldPath{}, findFn)
+	return err
+}
+
+// fieldPath describes location of a field in the loaded struct.
+type fieldPath struct {
+	fields []reflect.StructField
+	names  []string
+	index  []int
+	types  []reflect.Type
+}
+
+// child returns path of sf field nested in p. sf.Index is expected to be already the full index path.
+func (p fieldPath) child(sf reflect.StructField) fieldPath {
+	return fieldPath{
+		fields: append(p.fields[:len(p.fields):len(p.fields)], sf),
+		names:  append(p.names[:len(p.names):len(p.names)], sf.Name),
+		index:  sf.Index,
+		types:  p.types,
+	}
+}
+
+// enter returns p with struct type t added to walked types. It reports false if t was already walked on the path.
+func (p fieldPath) enter(t reflect.Type) (fieldPath, bool) {
+	types, ok := enterType(p.types, t)
+	if !ok {
+		return p, false
+	}
+
+	p.types = types
+	return p, true
+}
+
+// enterType returns copy of walked struct types with t appended. It reports false if t is already walked,
+// so recursive walk of self-referential type can stop.
+func enterType(walked []reflect.Type, t reflect.Type) ([]reflect.Type, bool) {
+	for _, w := range walked {
+		if w == t {
+			return walked, false
+		}
+	}
+	return append(walked[:len(walked):len(walked)], t), true
+}
+
+// name returns struct field names joined with dot.
+func (p fieldPath) name() string {
+	return strings.Join(p.names, ".")
+}
+
+// setStruct sets fields of struct value nested at path. It reports whether any field was set.
+// Nothing is set if struct type was already walked on the path.
+func (s *fieldSetter) setStruct(val reflect.Value, path fieldPath, findFn FieldValueFinder) (bool, error) {
+	valType := val.Type()
+	path, ok := path.enter(valType)
+	if !ok {
+		return false, nil
+	}
+
+	anySet := false
+	count := val.NumField()
 	for i := 0; i < count; i++ {
-		field := toVal.Field(i)
+		field := val.Field(i)
 
 		if !field.CanSet() {
 			continue
 		}
 
-		sf := toValType.Field(i)
-		key := s.options.Intercept(s.name, sf)
-		rawValue, err := findFn(key)
+		sf := valType.Field(i)
+		sf.Index = append(path.index[:len(path.index):len(path.index)], sf.Index...)
+		fieldPath := path.child(sf)
+
+		ok, err := s.setField(field, fieldPath, findFn)
 		if err != nil {
-			return err
+			return anySet, err
 		}
+		anySet = anySet || ok
+	}
 
-		// nil value is treated as not existing (so skip)
-		// return reflect.ValueOf(nil) to treat is as acual nil value
-		if rawValue == nil {
-			continue
+	return anySet, nil
+}
+
+// key returns key of field at path. Bind matches fields by their names, so tags and interceptors are not used.
+func (s *fieldSetter) key(path fieldPath) string {
+	if s.name == bindSetterName {
+		return strings.Join(path.names, KeySeparator(s.name))
+	}
+	return s.options.InterceptPath(s.name, path.fields)
+}
+
+// setField sets found value for field with key built from path. If no value was found and field is
+// a nested struct, its fields are set recursively. It reports whether any value was set.
+func (s *fieldSetter) setField(field reflect.Value, path fieldPath, findFn FieldValueFinder) (bool, error) {
+	key := s.key(path)
+	rawValue, err := findFn(key)
+	if err != nil {
+		return false, err
+	}
+
+	// nil value is treated as not existing (so skip)
+	// return reflect.ValueOf(nil) to treat is as acual nil value
+	if rawValue != nil {
+		if err := reflection.SetFieldValue(field, rawValue, s.options.parseOptions()...); err != nil {
+			return false, err
 		}
+		s.options.notifyFieldSet(s.name, path.name(), key)
+		return true, nil
+	}
 
-		err = reflection.SetFieldValue(field, rawValue)
-		if err != nil {
-			return err
+	fieldType := field.Type()
+	if isNestedStruct(fieldType) {
+		return s.setStruct(field, path, findFn)
+	}
+
+	if fieldType.Kind() == reflect.Pointer && isNestedStruct(fieldType.Elem()) {
+		if _, ok := path.enter(fieldType.Elem()); !ok {
+			return false, nil
+		}
+
+		if !field.IsNil() {
+			return s.setStruct(field.Elem(), path, findFn)
+		}
+
+		// pointer is set before nested fields, so FieldSetHook can already reach them
+		field.Set(reflect.New(fieldType.Elem()))
+		ok, err := s.setStruct(field.Elem(), path, findFn)
+		if !ok {
+			field.Set(reflect.Zero(fieldType))
 		}
+		return ok, err
 	}
 
-	return nil
+	return false, nil
+}
+
+// KeySeparator returns separator used to join nested field keys for provider with specified name.
+func KeySeparator(providerName string) string {
+	if providerName == EnvProviderName || providerName == SecretsProviderName {
+		return EnvKeySeparator
+	}
+	return DefaultKeySeparator
+}
+
+// isNestedStruct reports whether t is a struct type which fields should be set separately. Struct types
+// which are parsed as single values like time.Time or url.URL are not treated as nested ones.
+func isNestedStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && !reflection.IsTextType(t)
 }
 
 // valueLoadOf returns reflect.Value for struct pointer. If 'v' is not a pointer or struct it will return an error.
@@ -2,6 +2,8 @@ package config_test
 
 import (
 	"errors"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/Prastiwar/Go-flow/config"
@@ -32,6 +34,90 @@ func TestSetFields(t *testing.T) {
 				return &field, nil
 			},
 		},
+		{
+			name: "success-nested-struct",
+			assertWithValue: func(t *testing.T) (any, func(err error)) {
+				type Pool struct {
+					MaxSize int
+				}
+				type Database struct {
+					Name string
+					Pool Pool
+					Ptr  *Pool
+					Nil  *Pool
+				}
+				v := struct {
+					Database Database
+				}{}
+				return &v, func(err error) {
+					assert.NilError(t, err)
+					assert.Equal(t, "db", v.Database.Name)
+					assert.Equal(t, 10, v.Database.Pool.MaxSize)
+					assert.Equal(t, 20, v.Database.Ptr.MaxSize)
+					assert.Equal(t, true, v.Database.Nil == nil, "nil pointer should not be allocated")
+				}
+			},
+			opts: *config.NewLoadOptions(),
+			findFn: func(key string) (any, error) {
+				switch key {
+				case "Database.Name":
+					return "db", nil
+				case "Database.Pool.MaxSize":
+					return "10", nil
+				case "Database.Ptr.MaxSize":
+					return 20, nil
+				}
+				return nil, nil
+			},
+		},
+		{
+			name: "success-nested-interceptor-full-index",
+			assertWithValue: func(t *testing.T) (any, func(err error)) {
+				v := struct {
+					Outer struct {
+						Inner string
+					}
+				}{}
+				return &v, func(err error) {
+					assert.NilError(t, err)
+					assert.Equal(t, "value", v.Outer.Inner)
+				}
+			},
+			opts: *config.NewLoadOptions(config.WithInterceptor(func(providerName string, field reflect.StructField) string {
+				if len(field.Index) == 2 {
+					assert.Equal(t, []int{0, 0}, field.Index)
+				}
+				return strings.ToLower(field.Name)
+			})),
+			findFn: func(key string) (any, error) {
+				if key == "outer.inner" {
+					return "value", nil
+				}
+				return nil, nil
+			},
+		},
+		{
+			name: "success-self-referential-struct",
+			assertWithValue: func(t *testing.T) (any, func(err error)) {
+				type Node struct {
+					Name string
+					Next *Node
+				}
+				v := Node{}
+				return &v, func(err error) {
+					assert.NilError(t, err)
+					assert.Equal(t, "root", v.Name)
+					assert.Equal(t, true, v.Next == nil, "repeated type should not be walked")
+				}
+			},
+			opts: *config.NewLoadOptions(),
+			findFn: func(key string) (any, error) {
+				if key == "Name" {
+					return "root", nil
+				}
+				return nil, nil
+			},
+		},
 		{
 			name: "failure-find-fn-error",
 			assertWithValue: func(t *testing.T) (any, func(err error)) {
@@ -61,3 +147,99 @@ func TestSetFields(t *testing.T) {
 		})
 	}
 }
+
+func TestKeySeparator(t *testing.T) {
+	assert.Equal(t, config.EnvKeySeparator, config.KeySeparator(config.EnvProviderName))
+	assert.Equal(t, config.EnvKeySeparator, config.KeySeparator(config.SecretsProviderName))
+	assert.Equal(t, config.DefaultKeySeparator, config.KeySeparator(config.FlagProviderName))
+	assert.Equal(t, config.DefaultKeySeparator, config.KeySeparator("custom"))
+}
+
+func TestSetFieldsTagsAndHook(t *testing.T) {
+	v := struct {
+		Provider string `config:"shared" custom:"specific"`
+		Shared   string `config:"shared"`
+		Plain    string
+		Nested   struct {
+			Key string `config:"key"`
+		} `config:"nested"`
+	}{}
+
+	var reported []string
+	opts := config.NewLoadOptions(
+		config.WithInterceptor(func(providerName string, field reflect.StructField) string {
+			return strings.ToLower(field.Name)
+		}),
+		config.WithFieldSetHook(func(providerName, path, key string) {
+			assert.Equal(t, "custom", providerName)
+			reported = append(reported, path+"="+key)
+		}),
+	)
+
+	values := map[string]any{
+		"specific":   "provider",
+		"shared":     "shared",
+		"plain":      "plain",
+		"nested.key": "nested",
+	}
+
+	err := config.NewFieldSetter("custom", *opts).SetFields(&v, func(key string) (any, error) {
+		return values[key], nil
+	})
+
+	assert.NilError(t, err)
+	assert.Equal(t, "provider", v.Provider)
+	assert.Equal(t, "shared", v.Shared)
+	assert.Equal(t, "plain", v.Plain)
+	assert.Equal(t, "nested", v.Nested.Key)
+	assert.ElementsMatch(t, []string{
+		"Provider=specific",
+		"Shared=shared",
+		"Plain=plain",
+		"Nested.Key=nested.key",
+	}, reported)
+}
+
+func TestSetFieldsPathInterceptor(t *testing.T) {
+	type pool struct {
+		MaxSize int
+		MinSize int
+	}
+	v := struct {
+		Database struct {
+			Pool pool
+		}
+		Name string
+	}{}
+
+	opts := config.NewLoadOptions(
+		config.WithPathInterceptor(func(providerName string, path []reflect.StructField) string {
+			names := make([]string, len(path))
+			for i, f := range path {
+				names[i] = f.Name
+			}
+			if strings.Join(names, ".") == "Database.Pool.MaxSize" {
+				return "DB_POOL_MAX"
+			}
+			return ""
+		}),
+	)
+
+	values := map[string]any{
+		"DB_POOL_MAX":           "10",
+		"Database_Pool_MinSize": "2",
+		"Name":                  "app",
+	}
+
+	err := config.NewFieldSetter(config.EnvProviderName, *opts).SetFields(&v, func(key string) (any, error) {
+		if val, ok := values[key]; ok {
+			return val, nil
+		}
+		return nil, nil
+	})
+
+	assert.NilError(t, err)
+	assert.Equal(t, 10, v.Database.Pool.MaxSize)
+	assert.Equal(t, 2, v.Database.Pool.MinSize)
+	assert.Equal(t, "app", v.Name)
+}
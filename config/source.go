@@ -8,6 +8,8 @@ import (
 	"context"
 	"encoding/json"
 	"reflect"
+
+	"github.com/Prastiwar/Go-flow/exception"
 )
 
 // ensure config.Source can be abstracted with config.Provider
@@ -102,8 +104,29 @@ func (s *Source) Load(ctx context.Context, v any, opts ...LoadOption) error {
 // corresponding key value. LoadWithOptions can return ErrNonPointer or ErrNonStruct if v is not valid.
 // If field was not found in provider - it will not override the value. But it can be overridden by
 // provider which will be called as next in order if the value can be found.
+// Values of `default:"..."` tags are set before defaults from SetDefault. Fields tagged with `required:"true"`
+// which were not set by any provider are returned as exception.AggregatedError of ErrRequiredField errors
+// together with violations of validation rules checked by Validate. Before that ${ENV}, ${key.path} and
+// ${VAR:-default} expressions in string fields are resolved if WithInterpolation option is passed.
+// Field references are matched exactly by field name or json tag and take precedence over environment variables.
+// Fields tagged with `secret:"true"` or set by secrets provider are not interpolated.
+// If WithReport option is passed, the report is filled with provenance of each set field.
 func (s *Source) LoadWithOptions(ctx context.Context, v any, opts ...LoadOption) error {
-	if _, err := valueLoadOf(v); err != nil {
+	val, err := valueLoadOf(v)
+	if err != nil {
+		return err
+	}
+
+	options := NewLoadOptions(opts...)
+
+	var tracker *reportTracker
+	before := make(map[string]any)
+	if options.Report != nil {
+		tracker = newReportTracker(val)
+		leafValues(val, "", before)
+	}
+
+	if _, err := setTagDefaults(val, "", nil, options.parseOptions()); err != nil {
 		return err
 	}
 
@@ -111,17 +134,63 @@ func (s *Source) LoadWithOptions(ctx context.Context, v any, opts ...LoadOption)
 		return err
 	}
 
+	if tracker != nil {
+		tracker.recordChanged(DefaultProviderName, before)
+	}
+
+	set := make(map[string]struct{})
+	secrets := make(map[string]struct{})
+	opts = append(opts[:len(opts):len(opts)], WithFieldSetHook(func(providerName, path, key string) {
+		set[path] = struct{}{}
+		if providerName == SecretsProviderName {
+			secrets[path] = struct{}{}
+		}
+		if tracker != nil {
+			tracker.record(providerName, path)
+		}
+	}))
+
 	for _, p := range s.providers {
 		if err := p.Load(ctx, v, opts...); err != nil {
 			return err
 		}
 	}
 
+	if options.Interpolation {
+		tainted, err := interpolate(val, func(path string) bool {
+			_, ok := secrets[path]
+			return ok || isSecretField(val.Type(), path)
+		})
+		if err != nil {
+			return err
+		}
+
+		if tracker != nil {
+			for path := range tainted {
+				tracker.markSecret(path)
+			}
+		}
+	}
+
+	if tracker != nil {
+		*options.Report = tracker.report()
+	}
+
+	errs := checkRequired(requiredFields(val.Type(), "", nil), set)
+	validationErrs, err := validateValue(val, "")
+	if err != nil {
+		return err
+	}
+
+	errs = append(errs, validationErrs...)
+	if len(errs) > 0 {
+		return exception.Aggregate(errs...)
+	}
 	return nil
 }
 
-// Bind sets each 'to' field value from corresponding field from 'from'.
-// It will not return an error if will not find matching field.
+// Bind sets each 'to' field value from corresponding field from 'from'. Fields are matched by name,
+// config tags are ignored. It will not return an error if will not find matching field.
 func Bind(from any, to any) error {
 	if reflect.ValueOf(from).Kind() != reflect.Pointer {
 		return ErrNonPointer
@@ -132,7 +201,7 @@ func Bind(from any, to any) error {
 		return ErrNonStruct
 	}
 
-	setter := NewFieldSetter("bind", *NewLoadOptions())
+	setter := NewFieldSetter(bindSetterName, *NewLoadOptions())
 	return setter.SetFields(to, func(key string) (any, error) {
 		return fromVal.FieldByName(key), nil
 	})
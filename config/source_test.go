@@ -8,10 +8,12 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 	"unicode"
 
 	"github.com/Prastiwar/Go-flow/config"
 	"github.com/Prastiwar/Go-flow/datas"
+	"github.com/Prastiwar/Go-flow/exception"
 	"github.com/Prastiwar/Go-flow/tests/assert"
 )
 
@@ -231,6 +233,151 @@ func TestSourceLoad(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "success-tags",
+			init: func(t *testing.T) (*config.Source, any, func(error)) {
+				s := config.Provide(
+					config.NewReaderProvider(strings.NewReader(`{"fileName":"file"}`), datas.Json()),
+					config.NewFlagProvider(
+						config.StringFlag("db.host", "database host"),
+					),
+					config.NewEnvProvider(),
+				)
+
+				type Database struct {
+					Host string `flag:"host" required:"true"`
+					Port int    `default:"5432"`
+				}
+
+				v := struct {
+					FileName string        `json:"fileName" required:"true"`
+					Name     string        `config:"TAG_NAME" required:"true"`
+					Timeout  time.Duration `env:"TAG_TIMEOUT" default:"5s"`
+					Retries  int           `default:"3"`
+					Database *Database     `flag:"db"`
+				}{}
+
+				t.Setenv("TAG_NAME", "tagged")
+				t.Setenv("TAG_TIMEOUT", "10s")
+				setArgs("--db.host=localhost")
+
+				return s, &v, func(err error) {
+					assert.NilError(t, err)
+					assert.Equal(t, "file", v.FileName)
+					assert.Equal(t, "tagged", v.Name)
+					assert.Equal(t, 10*time.Second, v.Timeout)
+					assert.Equal(t, 3, v.Retries)
+					assert.Equal(t, "localhost", v.Database.Host)
+					assert.Equal(t, 5432, v.Database.Port)
+				}
+			},
+		},
+		{
+			name: "success-required-zero-values",
+			init: func(t *testing.T) (*config.Source, any, func(error)) {
+				s := config.Provide(
+					config.NewReaderProvider(strings.NewReader(`{"debug":false,"retries":0,"nested":{"name":""}}`), datas.Json()),
+				)
+
+				v := struct {
+					Debug   bool `json:"debug" required:"true" default:"true"`
+					Retries int  `json:"retries" required:"true" default:"3"`
+					Nested  struct {
+						Name string `json:"name" required:"true"`
+					} `json:"nested"`
+				}{}
+
+				return s, &v, func(err error) {
+					assert.NilError(t, err)
+					assert.Equal(t, false, v.Debug)
+					assert.Equal(t, 0, v.Retries)
+				}
+			},
+		},
+		{
+			name: "success-self-referential",
+			init: func(t *testing.T) (*config.Source, any, func(error)) {
+				s := config.Provide(
+					config.NewReaderProvider(strings.NewReader(`{"name":"first","next":{"name":"second"}}`), datas.Json()),
+					config.NewEnvProvider(),
+				)
+
+				type Node struct {
+					Name string `json:"name" required:"true"`
+					Next *Node  `json:"next"`
+				}
+
+				v := Node{}
+
+				return s, &v, func(err error) {
+					assert.NilError(t, err)
+					assert.Equal(t, "first", v.Name)
+					assert.Equal(t, "second", v.Next.Name)
+					assert.Equal(t, true, v.Next.Next == nil)
+				}
+			},
+		},
+		{
+			name: "invalid-required",
+			init: func(t *testing.T) (*config.Source, any, func(error)) {
+				s := config.Provide(config.NewEnvProvider())
+				err := s.SetDefault(config.Opt("Defaulted", "value"))
+				assert.NilError(t, err)
+
+				type Nested struct {
+					Key string `required:"true"`
+				}
+
+				v := struct {
+					Name      string `env:"REQUIRED_NAME" required:"true"`
+					Defaulted string `required:"true" default:"value"`
+					Optional  string `required:"false"`
+					Nested    Nested
+				}{}
+
+				return s, &v, func(err error) {
+					assert.ErrorIs(t, err, config.ErrRequiredField)
+					assert.ErrorType(t, err, exception.AggregatedError{})
+					assert.Equal(t, 3, len(err.(exception.AggregatedError)))
+					assert.ErrorWith(t, err, "Name")
+					assert.ErrorWith(t, err, "Defaulted")
+					assert.ErrorWith(t, err, "Nested.Key")
+				}
+			},
+		},
+		{
+			name: "invalid-default-tag",
+			init: func(t *testing.T) (*config.Source, any, func(error)) {
+				s := config.Provide()
+				v := struct {
+					Key int `default:"not-a-number"`
+				}{}
+
+				return s, &v, func(err error) {
+					assert.ErrorWith(t, err, "invalid default value for field 'Key'")
+				}
+			},
+		},
+		{
+			name: "invalid-validation",
+			init: func(t *testing.T) (*config.Source, any, func(error)) {
+				s := config.Provide()
+				v := struct {
+					Name string `required:"true"`
+					Port int    `default:"0" validate:"min=1,max=65535"`
+					Mode string `default:"debug" validate:"oneof=dev prod"`
+				}{}
+
+				return s, &v, func(err error) {
+					assert.ErrorType(t, err, exception.AggregatedError{})
+					assert.Equal(t, 3, len(err.(exception.AggregatedError)))
+					assert.ErrorIs(t, err, config.ErrRequiredField)
+					assert.ErrorIs(t, err, config.ErrInvalidField)
+					assert.ErrorWith(t, err, "field 'Port' does not satisfy 'min=1' rule")
+					assert.ErrorWith(t, err, "field 'Mode' does not satisfy 'oneof=dev prod' rule")
+				}
+			},
+		},
 		{
 			name: "invalid-non-pointer",
 			init: func(t *testing.T) (*config.Source, any, func(error)) {
@@ -313,6 +460,24 @@ func TestBind(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "success-tags-ignored",
+			init: func(t *testing.T) (any, any, func(error)) {
+				from := struct {
+					DbName string `config:"db"`
+				}{}
+				from.DbName = "test"
+
+				to := struct {
+					DbName string `config:"db_name" env:"DB_NAME"`
+				}{}
+
+				return &from, &to, func(err error) {
+					assert.NilError(t, err)
+					assert.Equal(t, "test", to.DbName)
+				}
+			},
+		},
 		{
 			name: "invalid-non-pointer",
 			init: func(t *testing.T) (any, any, func(error)) {
@@ -0,0 +1,204 @@
+package config
+
+import (
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/Prastiwar/Go-flow/datas"
+	"github.com/Prastiwar/Go-flow/exception"
+)
+
+// checkUnknownKeys decodes document from r into generic map and returns exception.AggregatedError with
+// ErrUnknownKey for each key which does not match any field of t struct. Keys are matched by decoder field tags
+// (see fieldTags).
+func checkUnknownKeys(decoder datas.ReaderUnmarshaler, r io.Reader, t reflect.Type) error {
+	var doc map[string]any
+	if err := decoder.UnmarshalFrom(r, &doc); err != nil {
+		return err
+	}
+
+	var errs []error
+	unknownKeys(t, fieldTags(decoder), doc, "", &errs)
+
+	if len(errs) > 0 {
+		return exception.Aggregate(errs...)
+	}
+	return nil
+}
+
+// unknownKeys appends ErrUnknownKey error for each doc key which does not match t type. Objects are checked
+// against struct fields named by tags, and elements of arrays and maps are checked against their element type.
+func unknownKeys(t reflect.Type, tags []string, doc any, path string, errs *[]error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := doc.(map[string]any)
+		if !ok || !isNestedStruct(t) {
+			return
+		}
+
+		for key, value := range obj {
+			keyPath := joinPath(path, key)
+			f, ok := fieldByKey(t, tags, key)
+			if !ok {
+				*errs = append(*errs, wrapErrUnknownKey(keyPath))
+				continue
+			}
+			unknownKeys(f.field.Type, tags, value, keyPath, errs)
+		}
+	case reflect.Map:
+		obj, ok := doc.(map[string]any)
+		if !ok {
+			return
+		}
+
+		for key, value := range obj {
+			unknownKeys(t.Elem(), tags, value, joinPath(path, key), errs)
+		}
+	case reflect.Slice, reflect.Array:
+		arr, ok := doc.([]any)
+		if !ok {
+			return
+		}
+
+		for i, value := range arr {
+			unknownKeys(t.Elem(), tags, value, path+"["+strconv.Itoa(i)+"]", errs)
+		}
+	}
+}
+
+// fieldTags returns names of struct tags used by decoder to match fields with document keys. Decoders which
+// do not implement datas.FieldTagger are expected to match fields as in JSON decoding.
+func fieldTags(decoder any) []string {
+	if tagger, ok := decoder.(datas.FieldTagger); ok {
+		return tagger.FieldTags()
+	}
+	return []string{"json"}
+}
+
+// documentField is a struct field matched by key of decoded document.
+type documentField struct {
+	key    string
+	field  reflect.StructField
+	path   string
+	depth  int
+	tagged bool
+}
+
+// fieldByKey returns t field matching key by name from the first present tags or field name preferring exact
+// match over case-insensitive one.
+func fieldByKey(t reflect.Type, tags []string, key string) (documentField, bool) {
+	fields := documentFields(t, tags)
+	for _, f := range fields {
+		if f.key == key {
+			return f, true
+		}
+	}
+
+	for _, f := range fields {
+		if strings.EqualFold(f.key, key) {
+			return f, true
+		}
+	}
+	return documentField{}, false
+}
+
+// documentFields returns t fields which can be set from document with keys named by tags. Fields of embedded
+// structs without tag name and fields with inline tag option are promoted as in JSON decoding - field hides fields
+// with the same key nested deeper and fields with the same key at the same depth are ignored unless exactly one
+// of them is tagged. Field index is the full index path and path is dot-separated path of struct field names,
+// e.g. "Base.Host".
+func documentFields(t reflect.Type, tags []string) []documentField {
+	var all []documentField
+	collectDocumentFields(t, tags, nil, "", 0, nil, &all)
+
+	fields := make([]documentField, 0, len(all))
+	for _, f := range all {
+		if isDominantField(f, all) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// collectDocumentFields appends each t field with its key to out. Embedded structs are walked recursively unless
+// their type was already walked.
+func collectDocumentFields(t reflect.Type, tags []string, index []int, path string, depth int, walked []reflect.Type, out *[]documentField) {
+	walked, ok := enterType(walked, t)
+	if !ok {
+		return
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		sf.Index = append(index[:len(index):len(index)], i)
+		fieldPath := joinPath(path, sf.Name)
+
+		tag := fieldTag(sf, tags)
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		inline := (sf.Anonymous && name == "") || containsOption(opts, "inline")
+		if inline {
+			fieldType := sf.Type
+			if fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				collectDocumentFields(fieldType, tags, sf.Index, fieldPath, depth+1, walked, out)
+				continue
+			}
+		}
+
+		if !sf.IsExported() {
+			continue
+		}
+
+		key := name
+		if key == "" {
+			key = sf.Name
+		}
+
+		*out = append(*out, documentField{key: key, field: sf, path: fieldPath, depth: depth, tagged: name != ""})
+	}
+}
+
+// fieldTag returns value of the first tags present on sf field.
+func fieldTag(sf reflect.StructField, tags []string) string {
+	for _, name := range tags {
+		if tag, ok := sf.Tag.Lookup(name); ok {
+			return tag
+		}
+	}
+	return ""
+}
+
+// containsOption reports whether comma-separated tag options contain option.
+func containsOption(opts, option string) bool {
+	for _, o := range strings.Split(opts, ",") {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+// isDominantField reports whether f is not hidden by other field with the same key.
+func isDominantField(f documentField, fields []documentField) bool {
+	for _, other := range fields {
+		if other.key != f.key || other.path == f.path {
+			continue
+		}
+		if other.depth < f.depth || (other.depth == f.depth && (other.tagged || !f.tagged)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,142 @@
+package config_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Prastiwar/Go-flow/config"
+	"github.com/Prastiwar/Go-flow/datas"
+	"github.com/Prastiwar/Go-flow/exception"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+type strictConfig struct {
+	Name     string `json:"name"`
+	Database struct {
+		Host string `json:"host"`
+	} `json:"database"`
+	Replicas []struct {
+		Host string
+	}
+	Labels  map[string]string
+	Ignored string `json:"-"`
+}
+
+type strictBase struct {
+	Host string
+	Port int `json:"port"`
+}
+
+type strictEmbeddedConfig struct {
+	strictBase
+	Port int `json:"port"`
+}
+
+func TestReaderProviderLoadStrictEmbedded(t *testing.T) {
+	var v strictEmbeddedConfig
+	var paths []string
+	p := config.NewReaderProvider(strings.NewReader(`{"Host":"x","port":1}`), datas.Json())
+
+	err := p.Load(context.Background(), &v, config.WithStrict(), config.WithFieldSetHook(func(providerName, path, key string) {
+		paths = append(paths, path)
+	}))
+
+	assert.NilError(t, err)
+	assert.Equal(t, "x", v.Host)
+	assert.Equal(t, 1, v.Port)
+	assert.Equal(t, 0, v.strictBase.Port)
+	assert.ElementsMatch(t, []string{"strictBase.Host", "Port"}, paths)
+
+	p = config.NewReaderProvider(strings.NewReader(`{"strictBase":{}}`), datas.Json())
+	err = p.Load(context.Background(), &v, config.WithStrict())
+	assert.ErrorIs(t, err, config.ErrUnknownKey)
+}
+
+func TestReaderProviderLoadStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		opts    []config.LoadOption
+		wantErr func(t *testing.T, err error)
+	}{
+		{
+			name:    "success",
+			content: `{"NAME":"app","database":{"Host":"db"},"replicas":[{"host":"r1"}],"labels":{"any":"key"}}`,
+			opts:    []config.LoadOption{config.WithStrict()},
+		},
+		{
+			name:    "success-not-strict",
+			content: `{"name":"app","nmae":"typo"}`,
+		},
+		{
+			name:    "invalid-unknown-keys",
+			content: `{"nmae":"typo","database":{"hots":"db"},"replicas":[{"host":"r1"},{"port":1}],"Ignored":"x"}`,
+			opts:    []config.LoadOption{config.WithStrict()},
+			wantErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, config.ErrUnknownKey)
+				assert.ErrorType(t, err, exception.AggregatedError{})
+				assert.ElementsMatch(t, []string{
+					"key 'nmae': key does not match any field",
+					"key 'database.hots': key does not match any field",
+					"key 'replicas[1].port': key does not match any field",
+					"key 'Ignored': key does not match any field",
+				}, errorMessages(err))
+			},
+		},
+		{
+			name:    "invalid-unknown-keys-with-hook",
+			content: `{"name":"app","nmae":"typo"}`,
+			opts: []config.LoadOption{config.WithStrict(), config.WithFieldSetHook(func(providerName, path, key string) {
+				t.Errorf("unexpected field set %v", path)
+			})},
+			wantErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, config.ErrUnknownKey)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v strictConfig
+			p := config.NewReaderProvider(strings.NewReader(tt.content), datas.Json())
+
+			err := p.Load(context.Background(), &v, tt.opts...)
+			if tt.wantErr != nil {
+				tt.wantErr(t, err)
+				assert.Equal(t, "", v.Name)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, "app", v.Name)
+		})
+	}
+}
+
+func TestReaderProviderLoadStrictYaml(t *testing.T) {
+	v := struct {
+		Host     string `yaml:"db_host"`
+		Port     int    `json:"port"`
+		Database struct {
+			MaxConns int `yaml:"max_conns"`
+		} `yaml:"db"`
+	}{}
+
+	p := config.NewReaderProvider(strings.NewReader("db_host: h\nport: 1\ndb:\n  max_conns: 5\n"), datas.Yaml())
+	err := p.Load(context.Background(), &v, config.WithStrict())
+
+	assert.NilError(t, err)
+	assert.Equal(t, "h", v.Host)
+	assert.Equal(t, 1, v.Port)
+	assert.Equal(t, 5, v.Database.MaxConns)
+
+	p = config.NewReaderProvider(strings.NewReader("Host: h\ndb:\n  MaxConn: 5\n"), datas.Yaml())
+	err = p.Load(context.Background(), &v, config.WithStrict())
+
+	assert.ErrorIs(t, err, config.ErrUnknownKey)
+	assert.ElementsMatch(t, []string{
+		"key 'Host': key does not match any field",
+		"key 'db.MaxConn': key does not match any field",
+	}, errorMessages(err))
+}
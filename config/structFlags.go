@@ -0,0 +1,206 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/Prastiwar/Go-flow/reflection"
+)
+
+// UsageTag defines usage description of flag registered by NewFlagProviderFor.
+const UsageTag = "usage"
+
+// -- reflected field Value
+type fieldValue struct {
+	typ   reflect.Type
+	value reflect.Value
+}
+
+func (f *fieldValue) Set(s string) error {
+	v, err := reflection.Parse(s, f.typ)
+	if err != nil {
+		return err
+	}
+
+	val := reflect.ValueOf(v)
+	if f.value.IsValid() && !reflection.IsTextType(f.typ) {
+		switch f.typ.Kind() {
+		case reflect.Slice:
+			val = reflect.AppendSlice(f.value, val)
+		case reflect.Map:
+			merged := reflect.MakeMap(f.typ)
+			for _, m := range []reflect.Value{f.value, val} {
+				iter := m.MapRange()
+				for iter.Next() {
+					merged.SetMapIndex(iter.Key(), iter.Value())
+				}
+			}
+			val = merged
+		}
+	}
+
+	f.value = val
+	return nil
+}
+
+func (f *fieldValue) Get() any {
+	if !f.value.IsValid() {
+		return reflect.Zero(f.typ).Interface()
+	}
+	return f.value.Interface()
+}
+
+func (f *fieldValue) String() string {
+	if !f.value.IsValid() {
+		return ""
+	}
+	return fmt.Sprint(f.value.Interface())
+}
+
+func (f *fieldValue) IsBoolFlag() bool { return f.typ != nil && f.typ.Kind() == reflect.Bool }
+
+func (f *fieldValue) reset() { f.value = reflect.Value{} }
+
+// structFlag is a flag registered for struct field.
+type structFlag struct {
+	flag.Flag
+	typ    reflect.Type
+	envKey string
+}
+
+// NewFlagProviderFor returns a new flag provider with flags registered for each v field, so they don't have to be
+// declared by hand. Nested struct fields are registered too. Flag names are keys of fields for FlagProviderName
+// provider, so they can be changed with `flag` or `config` tags and interceptors - the same opts should be passed
+// to Load. Usage is taken from `usage` tag and default value from `default` tag or current non-zero field value.
+// Passing -h or -help flag prints help listing flags with their defaults and names of matching environment
+// variables prefixed with envPrefix, then Load returns flag.ErrHelp.
+func NewFlagProviderFor(v any, envPrefix string, opts ...LoadOption) (*flagProvider, error) {
+	val, err := valueLoadOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	options := NewLoadOptions(opts...)
+	flags := structFlags(val, options, nil, nil, nil)
+
+	fs := make([]flag.Flag, len(flags))
+	for i, f := range flags {
+		fs[i] = f.Flag
+	}
+
+	p := NewFlagProvider(fs...)
+	p.set.Usage = func() {
+		writeHelp(p.set.Output(), p.set.Name(), flags, envPrefix)
+	}
+
+	return p, nil
+}
+
+// structFlags returns flags for each val field which type can be parsed from string.
+// Struct type already included in walked types is not walked again.
+func structFlags(val reflect.Value, options *LoadOptions, path []reflect.StructField, index []int, walked []reflect.Type) []structFlag {
+	valType := val.Type()
+	walked, ok := enterType(walked, valType)
+	if !ok {
+		return nil
+	}
+
+	var flags []structFlag
+	for i := 0; i < val.NumField(); i++ {
+		sf := valType.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		field := val.Field(i)
+		sf.Index = append(index[:len(index):len(index)], sf.Index...)
+		fieldPath := append(path[:len(path):len(path)], sf)
+
+		fieldType := sf.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+			if field.IsNil() {
+				field = reflect.Zero(fieldType)
+			} else {
+				field = field.Elem()
+			}
+		}
+
+		if isNestedStruct(fieldType) {
+			flags = append(flags, structFlags(field, options, fieldPath, sf.Index, walked)...)
+			continue
+		}
+
+		if !isFlagType(fieldType) {
+			continue
+		}
+
+		value := &fieldValue{typ: fieldType}
+		f := CustomFlag(options.InterceptPath(FlagProviderName, fieldPath), sf.Tag.Get(UsageTag), value)
+		if def, ok := sf.Tag.Lookup(DefaultTag); ok {
+			f.DefValue = def
+		} else if !field.IsZero() {
+			f.DefValue = fmt.Sprint(field.Interface())
+		}
+
+		flags = append(flags, structFlag{
+			Flag:   f,
+			typ:    fieldType,
+			envKey: options.InterceptPath(EnvProviderName, fieldPath),
+		})
+	}
+
+	return flags
+}
+
+// isFlagType reports whether value of t type can be parsed from flag argument.
+func isFlagType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.UnsafePointer, reflect.Struct, reflect.Array:
+		return reflection.IsTextType(t)
+	case reflect.Slice:
+		return reflection.IsTextType(t) || isFlagType(t.Elem())
+	case reflect.Map:
+		return isFlagType(t.Key()) && isFlagType(t.Elem())
+	}
+	return true
+}
+
+// writeHelp writes listing of flags in format similar to flag.PrintDefaults with additional environment variable name.
+func writeHelp(w io.Writer, name string, flags []structFlag, envPrefix string) {
+	if name == "" {
+		fmt.Fprintf(w, "Usage:\n")
+	} else {
+		fmt.Fprintf(w, "Usage of %s:\n", name)
+	}
+
+	for _, f := range flags {
+		var b strings.Builder
+		b.WriteString("  -")
+		b.WriteString(f.Name)
+		if f.typ.Kind() != reflect.Bool {
+			b.WriteString(" ")
+			b.WriteString(f.typ.String())
+		}
+		b.WriteString("\n    \t")
+
+		var details []string
+		if f.Usage != "" {
+			details = append(details, strings.ReplaceAll(f.Usage, "\n", "\n    \t"))
+		}
+		if f.DefValue != "" {
+			if f.typ.Kind() == reflect.String {
+				details = append(details, fmt.Sprintf("(default %q)", f.DefValue))
+			} else {
+				details = append(details, fmt.Sprintf("(default %v)", f.DefValue))
+			}
+		}
+		details = append(details, fmt.Sprintf("(env %v%v)", envPrefix, f.envKey))
+		b.WriteString(strings.Join(details, " "))
+
+		fmt.Fprintln(w, b.String())
+	}
+}
@@ -0,0 +1,144 @@
+package config_test
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode"
+
+	"github.com/Prastiwar/Go-flow/config"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+type structFlagsConfig struct {
+	Name     string        `usage:"service name" default:"svc"`
+	Verbose  bool          `usage:"verbose logging"`
+	Timeout  time.Duration `flag:"timeout" usage:"request timeout"`
+	Tags     []string      `usage:"list of tags"`
+	Limits   map[string]int
+	Endpoint *url.URL
+	Database struct {
+		Host string `usage:"database host"`
+		Port int
+	}
+	Cache *struct {
+		Size int
+	}
+	Handler func()
+	hidden  string
+}
+
+func lowerFirst(providerName string, field reflect.StructField) string {
+	if providerName == config.EnvProviderName {
+		return strings.ToUpper(field.Name)
+	}
+
+	a := []rune(field.Name)
+	a[0] = unicode.ToLower(a[0])
+	return string(a)
+}
+
+func TestNewFlagProviderFor(t *testing.T) {
+	v := structFlagsConfig{}
+	v.Database.Port = 5432
+
+	p, err := config.NewFlagProviderFor(&v, "APP_", config.WithInterceptor(lowerFirst))
+	assert.NilError(t, err)
+
+	setArgs(
+		"-name=api",
+		"-verbose",
+		"-timeout=5s",
+		"-tags=a,b",
+		"-tags=c",
+		"-limits=read=1",
+		"-limits=write=2",
+		"-endpoint=https://example.com",
+		"-database.host=db",
+		"-cache.size=10",
+	)
+
+	err = p.Load(context.Background(), &v, config.WithInterceptor(lowerFirst))
+	assert.NilError(t, err)
+
+	assert.Equal(t, "api", v.Name)
+	assert.Equal(t, true, v.Verbose)
+	assert.Equal(t, 5*time.Second, v.Timeout)
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, v.Tags)
+	assert.MapMatch(t, map[string]int{"read": 1, "write": 2}, v.Limits)
+	assert.Equal(t, "example.com", v.Endpoint.Host)
+	assert.Equal(t, "db", v.Database.Host)
+	assert.Equal(t, 5432, v.Database.Port)
+	assert.Equal(t, 10, v.Cache.Size)
+}
+
+func TestNewFlagProviderForHelp(t *testing.T) {
+	v := structFlagsConfig{}
+	v.Database.Port = 5432
+
+	p, err := config.NewFlagProviderFor(&v, "APP_", config.WithInterceptor(lowerFirst))
+	assert.NilError(t, err)
+
+	var out bytes.Buffer
+	p.SetOutput(&out)
+	setArgs("-help")
+
+	err = p.Load(context.Background(), &v, config.WithInterceptor(lowerFirst))
+	assert.ErrorIs(t, err, flag.ErrHelp)
+
+	expected := strings.Join([]string{
+		"Usage of configs.FlagProvider:",
+		"  -name string",
+		"    \tservice name (default \"svc\") (env APP_NAME)",
+		"  -verbose",
+		"    \tverbose logging (env APP_VERBOSE)",
+		"  -timeout time.Duration",
+		"    \trequest timeout (env APP_TIMEOUT)",
+		"  -tags []string",
+		"    \tlist of tags (env APP_TAGS)",
+		"  -limits map[string]int",
+		"    \t(env APP_LIMITS)",
+		"  -endpoint url.URL",
+		"    \t(env APP_ENDPOINT)",
+		"  -database.host string",
+		"    \tdatabase host (env APP_DATABASE_HOST)",
+		"  -database.port int",
+		"    \t(default 5432) (env APP_DATABASE_PORT)",
+		"  -cache.size int",
+		"    \t(env APP_CACHE_SIZE)",
+		"",
+	}, "\n")
+	assert.Equal(t, expected, out.String())
+}
+
+func TestNewFlagProviderForSelfReferential(t *testing.T) {
+	type Node struct {
+		Name string
+		Next *Node
+	}
+
+	v := Node{}
+	p, err := config.NewFlagProviderFor(&v, "")
+	assert.NilError(t, err)
+
+	setArgs("-Name=root")
+
+	err = p.Load(context.Background(), &v)
+	assert.NilError(t, err)
+	assert.Equal(t, "root", v.Name)
+	assert.Equal(t, true, v.Next == nil)
+}
+
+func TestNewFlagProviderForInvalid(t *testing.T) {
+	_, err := config.NewFlagProviderFor(structFlagsConfig{}, "")
+	assert.ErrorIs(t, err, config.ErrNonPointer)
+
+	v := 1
+	_, err = config.NewFlagProviderFor(&v, "")
+	assert.ErrorIs(t, err, config.ErrNonStruct)
+}
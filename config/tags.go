@@ -0,0 +1,138 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/Prastiwar/Go-flow/reflection"
+)
+
+// setTagDefaults parses DefaultTag value of each val field and stores it in the field. Nested struct fields
+// are walked recursively and nil pointer-to-struct is allocated only if any of its nested fields has default value.
+// Struct type already included in walked types is not walked again. It reports whether any field was set.
+func setTagDefaults(val reflect.Value, path string, walked []reflect.Type, opts []reflection.ParseOption) (bool, error) {
+	valType := val.Type()
+	walked, ok := enterType(walked, valType)
+	if !ok {
+		return false, nil
+	}
+
+	anySet := false
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+
+		sf := valType.Field(i)
+		fieldPath := joinPath(path, sf.Name)
+
+		if def, ok := sf.Tag.Lookup(DefaultTag); ok {
+			if err := reflection.SetFieldValue(field, def, opts...); err != nil {
+				return anySet, wrapErrInvalidDefault(fieldPath, err)
+			}
+			anySet = true
+			continue
+		}
+
+		ok, err := setNestedTagDefaults(field, fieldPath, walked, opts)
+		if err != nil {
+			return anySet, err
+		}
+		anySet = anySet || ok
+	}
+
+	return anySet, nil
+}
+
+func setNestedTagDefaults(field reflect.Value, path string, walked []reflect.Type, opts []reflection.ParseOption) (bool, error) {
+	fieldType := field.Type()
+	if isNestedStruct(fieldType) {
+		return setTagDefaults(field, path, walked, opts)
+	}
+
+	if fieldType.Kind() == reflect.Pointer && isNestedStruct(fieldType.Elem()) {
+		if _, ok := enterType(walked, fieldType.Elem()); !ok {
+			return false, nil
+		}
+
+		if !field.IsNil() {
+			return setTagDefaults(field.Elem(), path, walked, opts)
+		}
+
+		p := reflect.New(fieldType.Elem())
+		ok, err := setTagDefaults(p.Elem(), path, walked, opts)
+		if ok {
+			field.Set(p)
+		}
+		return ok, err
+	}
+
+	return false, nil
+}
+
+// requiredFields returns paths of t struct fields tagged with `required:"true"` including nested ones.
+// Struct type already included in walked types is not walked again.
+func requiredFields(t reflect.Type, path string, walked []reflect.Type) []string {
+	walked, ok := enterType(walked, t)
+	if !ok {
+		return nil
+	}
+
+	var paths []string
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		fieldPath := joinPath(path, sf.Name)
+		if sf.Tag.Get(RequiredTag) == "true" {
+			paths = append(paths, fieldPath)
+		}
+
+		fieldType := sf.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if isNestedStruct(fieldType) {
+			paths = append(paths, requiredFields(fieldType, fieldPath, walked)...)
+		}
+	}
+
+	return paths
+}
+
+// checkRequired returns ErrRequiredField error for each required path which is not included in set paths.
+// Struct field is treated as set if any of its nested fields was set.
+func checkRequired(required []string, set map[string]struct{}) []error {
+	var errs []error
+	for _, path := range required {
+		if !isPathSet(path, set) {
+			errs = append(errs, wrapErrRequiredField(path))
+		}
+	}
+	return errs
+}
+
+func isPathSet(path string, set map[string]struct{}) bool {
+	if _, ok := set[path]; ok {
+		return true
+	}
+
+	prefix := path + "."
+	for p := range set {
+		if strings.HasPrefix(p, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// joinPath returns name appended to path with dot separator.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
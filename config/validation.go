@@ -0,0 +1,266 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/exception"
+)
+
+// Validator is implemented by any value that has a Validate method. It's called by Validate
+// after tag rules of the value fields are checked.
+type Validator interface {
+	Validate() error
+}
+
+// FieldError is a violation of validation rule by field at Path.
+type FieldError struct {
+	Path string
+	Rule string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("field '%v' does not satisfy '%v' rule", e.Path, e.Rule)
+}
+
+func (e *FieldError) Unwrap() error {
+	return ErrInvalidField
+}
+
+type validationRule struct {
+	name  string
+	param string
+}
+
+func (r validationRule) String() string {
+	if r.param == "" {
+		return r.name
+	}
+	return r.name + "=" + r.param
+}
+
+// Validate checks rules declared in `validate` tag of each v field and calls Validate method of v and its nested
+// struct fields implementing Validator. Rules are separated with comma, e.g. `validate:"nonzero,min=1,max=10"`:
+//   - nonzero - value is not zero value of its type,
+//   - min=n, max=n - number is in range or length of string, slice or map is in range. time.Duration
+//     is compared with duration, e.g. min=1s,
+//   - oneof=a b c - value is one of space-separated values,
+//   - url - value is absolute URL with scheme and host,
+//   - regex=expr - string matches regular expression. It must be the last rule, so expr can contain commas.
+//
+// Rules are not checked for nil pointers except nonzero rule. All violations are returned as single
+// exception.AggregatedError containing FieldError for tag rules and errors returned by Validate methods
+// wrapped with field path. Invalid rule declaration is returned as ErrInvalidRule error.
+func Validate(v any) error {
+	val := reflect.ValueOf(v)
+	errs, err := validateValue(val, "")
+	if err != nil {
+		return err
+	}
+
+	if len(errs) > 0 {
+		return exception.Aggregate(errs...)
+	}
+	return nil
+}
+
+// validateValue returns violations of val and its fields. Error is returned only if rule declaration is invalid.
+func validateValue(val reflect.Value, path string) ([]error, error) {
+	for val.Kind() == reflect.Pointer {
+		if val.IsNil() {
+			return nil, nil
+		}
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return nil, nil
+	}
+
+	var errs []error
+	valType := val.Type()
+	for i := 0; i < val.NumField(); i++ {
+		sf := valType.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		field := val.Field(i)
+		fieldPath := joinPath(path, sf.Name)
+
+		rules, err := parseRules(sf.Tag.Get(ValidateTag))
+		if err != nil {
+			return nil, fmt.Errorf("field '%v': %w", fieldPath, err)
+		}
+
+		for _, rule := range rules {
+			ok, err := checkRule(field, rule)
+			if err != nil {
+				return nil, fmt.Errorf("field '%v': %w", fieldPath, err)
+			}
+			if !ok {
+				errs = append(errs, &FieldError{Path: fieldPath, Rule: rule.String()})
+			}
+		}
+
+		fieldType := field.Type()
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if isNestedStruct(fieldType) {
+			nestedErrs, err := validateValue(field, fieldPath)
+			if err != nil {
+				return nil, err
+			}
+			errs = append(errs, nestedErrs...)
+		}
+	}
+
+	if err := callValidate(val); err != nil {
+		if path != "" {
+			err = fmt.Errorf("field '%v': %w", path, err)
+		}
+		errs = append(errs, err)
+	}
+
+	return errs, nil
+}
+
+// callValidate calls Validate method if val or pointer to val implements Validator.
+func callValidate(val reflect.Value) error {
+	if val.CanAddr() {
+		if v, ok := val.Addr().Interface().(Validator); ok {
+			return v.Validate()
+		}
+	}
+
+	if v, ok := val.Interface().(Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// parseRules parses rules declared in validate tag.
+func parseRules(tag string) ([]validationRule, error) {
+	var rules []validationRule
+	for tag != "" {
+		var spec string
+		if strings.HasPrefix(tag, "regex=") {
+			spec, tag = tag, ""
+		} else {
+			spec, tag, _ = strings.Cut(tag, ",")
+		}
+
+		name, param, _ := strings.Cut(strings.TrimSpace(spec), "=")
+		switch name {
+		case "nonzero", "url":
+			if param != "" {
+				return nil, wrapErrInvalidRule(spec)
+			}
+		case "min", "max", "oneof":
+			if param == "" {
+				return nil, wrapErrInvalidRule(spec)
+			}
+		case "regex":
+			if _, err := regexp.Compile(param); err != nil {
+				return nil, wrapErrInvalidRule(spec)
+			}
+		default:
+			return nil, wrapErrInvalidRule(spec)
+		}
+
+		rules = append(rules, validationRule{name: name, param: param})
+	}
+
+	return rules, nil
+}
+
+// checkRule reports whether field satisfies the rule. Error is returned if rule cannot be applied to field type.
+func checkRule(field reflect.Value, rule validationRule) (bool, error) {
+	if rule.name == "nonzero" {
+		return !field.IsZero(), nil
+	}
+
+	for field.Kind() == reflect.Pointer {
+		if field.IsNil() {
+			return true, nil
+		}
+		field = field.Elem()
+	}
+
+	switch rule.name {
+	case "min", "max":
+		return checkRange(field, rule)
+	case "oneof":
+		s := fmt.Sprint(field.Interface())
+		for _, allowed := range strings.Fields(rule.param) {
+			if s == allowed {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "url":
+		var s string
+		switch v := field.Interface().(type) {
+		case url.URL:
+			s = v.String()
+		case string:
+			s = v
+		default:
+			if field.Kind() != reflect.String {
+				return false, wrapErrInvalidRule(rule.String())
+			}
+			s = field.String()
+		}
+		u, err := url.Parse(s)
+		return err == nil && u.Scheme != "" && u.Host != "", nil
+	case "regex":
+		if field.Kind() != reflect.String {
+			return false, wrapErrInvalidRule(rule.String())
+		}
+		return regexp.MustCompile(rule.param).MatchString(field.String()), nil
+	}
+
+	return false, wrapErrInvalidRule(rule.String())
+}
+
+// checkRange reports whether number, duration or length of field is within min or max rule limit.
+func checkRange(field reflect.Value, rule validationRule) (bool, error) {
+	cmp := func(actual, limit float64) bool {
+		if rule.name == "min" {
+			return actual >= limit
+		}
+		return actual <= limit
+	}
+
+	if field.Type() == reflect.TypeOf(time.Duration(0)) {
+		limit, err := time.ParseDuration(rule.param)
+		if err != nil {
+			return false, wrapErrInvalidRule(rule.String())
+		}
+		return cmp(float64(field.Int()), float64(limit)), nil
+	}
+
+	limit, err := strconv.ParseFloat(rule.param, 64)
+	if err != nil {
+		return false, wrapErrInvalidRule(rule.String())
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp(float64(field.Int()), limit), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp(float64(field.Uint()), limit), nil
+	case reflect.Float32, reflect.Float64:
+		return cmp(field.Float(), limit), nil
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return cmp(float64(field.Len()), limit), nil
+	}
+
+	return false, wrapErrInvalidRule(rule.String())
+}
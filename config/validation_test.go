@@ -0,0 +1,182 @@
+package config_test
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/config"
+	"github.com/Prastiwar/Go-flow/exception"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+var errPoolSize = errors.New("pool size cannot exceed max connections")
+
+type validatedDatabase struct {
+	MaxConns int `validate:"min=1"`
+	PoolSize int
+}
+
+func (d validatedDatabase) Validate() error {
+	if d.PoolSize > d.MaxConns {
+		return errPoolSize
+	}
+	return nil
+}
+
+type validatedConfig struct {
+	Name     string `validate:"nonzero"`
+	Database validatedDatabase
+}
+
+func (c *validatedConfig) Validate() error {
+	if c.Name == "forbidden" {
+		return errors.New("name is forbidden")
+	}
+	return nil
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       any
+		wantErr func(t *testing.T, err error)
+	}{
+		{
+			name: "success",
+			v: &struct {
+				Port     int           `validate:"nonzero,min=1,max=65535"`
+				Ratio    float64       `validate:"min=0,max=1"`
+				Timeout  time.Duration `validate:"min=1s,max=1m"`
+				Mode     string        `validate:"oneof=dev prod"`
+				Level    uint8         `validate:"oneof=1 2 3"`
+				Endpoint string        `validate:"url"`
+				Proxy    url.URL       `validate:"url"`
+				Hosts    []string      `validate:"min=1,max=2"`
+				Code     string        `validate:"regex=^[a-z]{2,3}$"`
+				Optional *int          `validate:"min=1"`
+				Labels   map[string]string
+			}{
+				Port:     8080,
+				Ratio:    0.5,
+				Timeout:  30 * time.Second,
+				Mode:     "prod",
+				Level:    2,
+				Endpoint: "https://example.com/api",
+				Proxy:    url.URL{Scheme: "http", Host: "proxy:3128"},
+				Hosts:    []string{"a"},
+				Code:     "ab",
+			},
+		},
+		{
+			name: "success-validator",
+			v: &validatedConfig{
+				Name:     "app",
+				Database: validatedDatabase{MaxConns: 10, PoolSize: 5},
+			},
+		},
+		{
+			name: "invalid-rules",
+			v: &struct {
+				Port     int           `validate:"min=1"`
+				Ratio    float64       `validate:"max=1"`
+				Timeout  time.Duration `validate:"max=1m"`
+				Mode     string        `validate:"oneof=dev prod"`
+				Endpoint string        `validate:"url"`
+				Hosts    []string      `validate:"nonzero,max=1"`
+				Code     string        `validate:"regex=^[a-z]{2,3}$"`
+				Nested   *struct {
+					Key string `validate:"nonzero"`
+				}
+			}{
+				Ratio:    1.5,
+				Timeout:  time.Hour,
+				Mode:     "debug",
+				Endpoint: "example.com",
+				Code:     "abcd",
+				Nested: &struct {
+					Key string `validate:"nonzero"`
+				}{},
+			},
+			wantErr: func(t *testing.T, err error) {
+				assert.ErrorType(t, err, exception.AggregatedError{})
+				assert.ErrorIs(t, err, config.ErrInvalidField)
+				assert.ElementsMatch(t, []string{
+					"field 'Port' does not satisfy 'min=1' rule",
+					"field 'Ratio' does not satisfy 'max=1' rule",
+					"field 'Timeout' does not satisfy 'max=1m' rule",
+					"field 'Mode' does not satisfy 'oneof=dev prod' rule",
+					"field 'Endpoint' does not satisfy 'url' rule",
+					"field 'Hosts' does not satisfy 'nonzero' rule",
+					"field 'Code' does not satisfy 'regex=^[a-z]{2,3}$' rule",
+					"field 'Nested.Key' does not satisfy 'nonzero' rule",
+				}, errorMessages(err))
+			},
+		},
+		{
+			name: "invalid-validator",
+			v: &validatedConfig{
+				Name:     "forbidden",
+				Database: validatedDatabase{PoolSize: 5},
+			},
+			wantErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, errPoolSize)
+				assert.ElementsMatch(t, []string{
+					"field 'Database.MaxConns' does not satisfy 'min=1' rule",
+					"field 'Database': pool size cannot exceed max connections",
+					"name is forbidden",
+				}, errorMessages(err))
+			},
+		},
+		{
+			name: "invalid-unknown-rule",
+			v: &struct {
+				Port int `validate:"positive"`
+			}{},
+			wantErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, config.ErrInvalidRule)
+				assert.ErrorWith(t, err, "field 'Port'")
+			},
+		},
+		{
+			name: "invalid-rule-type",
+			v: &struct {
+				Enabled bool `validate:"min=1"`
+			}{},
+			wantErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, config.ErrInvalidRule)
+			},
+		},
+		{
+			name: "invalid-regex",
+			v: &struct {
+				Code string `validate:"regex=[a-"`
+			}{},
+			wantErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, config.ErrInvalidRule)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := config.Validate(tt.v)
+			if tt.wantErr != nil {
+				tt.wantErr(t, err)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func errorMessages(err error) []string {
+	errs := err.(exception.AggregatedError)
+	messages := make([]string, len(errs))
+	for i, e := range errs {
+		messages[i] = e.Error()
+	}
+	return messages
+}
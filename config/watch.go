@@ -0,0 +1,215 @@
+package config
+
+import (
+	"context"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const defaultPollInterval = time.Second
+
+// ValidateFunc validates freshly loaded configuration before it's published.
+type ValidateFunc func(v any) error
+
+type WatchOption func(*WatchOptions)
+
+// WatchOptions stores settings to control behaviour of Watcher.
+type WatchOptions struct {
+	PollInterval time.Duration
+	Validate     ValidateFunc
+	ErrorHandler func(err error)
+	LoadOptions  []LoadOption
+}
+
+// NewWatchOptions returns WatchOptions with 1 second PollInterval overridden by specified options.
+func NewWatchOptions(options ...WatchOption) *WatchOptions {
+	opts := &WatchOptions{
+		PollInterval: defaultPollInterval,
+	}
+	for _, o := range options {
+		o(opts)
+	}
+
+	return opts
+}
+
+// WithPollInterval sets how often the file is checked for modification. Default interval is 1 second and it must be positive.
+func WithPollInterval(interval time.Duration) WatchOption {
+	return func(o *WatchOptions) {
+		o.PollInterval = interval
+	}
+}
+
+// WithValidate sets function called on freshly loaded configuration. If it returns an error,
+// the configuration is not published and the previous one is kept.
+func WithValidate(fn ValidateFunc) WatchOption {
+	return func(o *WatchOptions) {
+		o.Validate = fn
+	}
+}
+
+// WithReloadErrorHandler sets function called with error of failed reload triggered by file modification.
+func WithReloadErrorHandler(fn func(err error)) WatchOption {
+	return func(o *WatchOptions) {
+		o.ErrorHandler = fn
+	}
+}
+
+// WithWatchLoadOptions sets options passed to provider on each load.
+func WithWatchLoadOptions(opts ...LoadOption) WatchOption {
+	return func(o *WatchOptions) {
+		o.LoadOptions = opts
+	}
+}
+
+type subscriber[T any] struct {
+	id int
+	fn func(old, new *T)
+}
+
+// Watcher keeps the latest valid configuration of T type loaded from provider and reloads it when the
+// watched file is modified. Each reload loads configuration into a fresh T value, so the published value
+// is never modified and can be safely shared between goroutines.
+type Watcher[T any] struct {
+	provider Provider
+	filename string
+	options  WatchOptions
+
+	current atomic.Pointer[T]
+
+	reloadMu sync.Mutex
+	modTime  time.Time
+	size     int64
+
+	mu          sync.Mutex
+	subscribers []subscriber[T]
+	nextID      int
+}
+
+// Watch loads configuration of T type from provider and returns Watcher which polls filename for modifications
+// until ctx is done. Provider is usually a Source containing file provider for the same filename, so the file can
+// be still overridden by other providers like environment variables. It returns an error if initial load fails
+// or ErrInvalidPollInterval if configured PollInterval is not positive.
+func Watch[T any](ctx context.Context, provider Provider, filename string, opts ...WatchOption) (*Watcher[T], error) {
+	w := &Watcher[T]{
+		provider: provider,
+		filename: filename,
+		options:  *NewWatchOptions(opts...),
+	}
+
+	if w.options.PollInterval <= 0 {
+		return nil, wrapErrInvalidPollInterval(w.options.PollInterval)
+	}
+
+	if err := w.Reload(ctx); err != nil {
+		return nil, err
+	}
+
+	go w.poll(ctx)
+
+	return w, nil
+}
+
+// Current returns the latest valid configuration. Returned value must not be modified.
+func (w *Watcher[T]) Current() *T {
+	return w.current.Load()
+}
+
+// Subscribe registers fn which is called with previous and new configuration after each successful reload.
+// Returned function removes the subscription.
+func (w *Watcher[T]) Subscribe(fn func(old, new *T)) (unsubscribe func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	id := w.nextID
+	w.nextID++
+	w.subscribers = append(w.subscribers, subscriber[T]{id: id, fn: fn})
+
+	return func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		for i, s := range w.subscribers {
+			if s.id == id {
+				w.subscribers = append(w.subscribers[:i:i], w.subscribers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Reload loads configuration into a fresh value, validates it and publishes it notifying subscribers.
+// If loading or validation fails, the error is returned and the current configuration is kept.
+// Subscribers are called synchronously, so they must not call Reload.
+func (w *Watcher[T]) Reload(ctx context.Context) error {
+	w.reloadMu.Lock()
+	defer w.reloadMu.Unlock()
+
+	// file is stated before load, so modification during load is picked up by next poll
+	if stat, err := os.Stat(w.filename); err == nil {
+		w.modTime = stat.ModTime()
+		w.size = stat.Size()
+	}
+
+	v := new(T)
+	if err := w.provider.Load(ctx, v, w.options.LoadOptions...); err != nil {
+		return err
+	}
+
+	if w.options.Validate != nil {
+		if err := w.options.Validate(v); err != nil {
+			return err
+		}
+	}
+
+	old := w.current.Swap(v)
+	if old == nil {
+		return nil
+	}
+
+	w.mu.Lock()
+	subscribers := append([]subscriber[T](nil), w.subscribers...)
+	w.mu.Unlock()
+
+	for _, s := range subscribers {
+		s.fn(old, v)
+	}
+
+	return nil
+}
+
+// poll checks file modification every PollInterval and reloads configuration if it was modified.
+func (w *Watcher[T]) poll(ctx context.Context) {
+	ticker := time.NewTicker(w.options.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !w.modified() {
+				continue
+			}
+
+			if err := w.Reload(ctx); err != nil && w.options.ErrorHandler != nil {
+				w.options.ErrorHandler(err)
+			}
+		}
+	}
+}
+
+// modified reports whether file modification time or size is different than on last reload.
+func (w *Watcher[T]) modified() bool {
+	stat, err := os.Stat(w.filename)
+	if err != nil {
+		return false
+	}
+
+	w.reloadMu.Lock()
+	defer w.reloadMu.Unlock()
+
+	return !stat.ModTime().Equal(w.modTime) || stat.Size() != w.size
+}
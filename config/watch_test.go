@@ -0,0 +1,135 @@
+package config_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/config"
+	"github.com/Prastiwar/Go-flow/datas"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+)
+
+type watchedConfig struct {
+	Name  string `json:"name"`
+	Level int    `json:"level"`
+}
+
+func writeWatchedFile(t *testing.T, filename string, content string) {
+	t.Helper()
+	assert.NilError(t, os.WriteFile(filename, []byte(content), 0o600))
+}
+
+func TestWatchReload(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "config.json")
+	writeWatchedFile(t, filename, `{"name":"initial","level":1}`)
+
+	validateErr := errors.New("level must be positive")
+	w, err := config.Watch[watchedConfig](context.Background(), config.NewFileProvider(filename, datas.Json()), filename,
+		config.WithPollInterval(time.Hour),
+		config.WithValidate(func(v any) error {
+			if v.(*watchedConfig).Level <= 0 {
+				return validateErr
+			}
+			return nil
+		}),
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, watchedConfig{Name: "initial", Level: 1}, *w.Current())
+
+	var notified []string
+	unsubscribe := w.Subscribe(func(old, new *watchedConfig) {
+		notified = append(notified, old.Name+"->"+new.Name)
+	})
+
+	writeWatchedFile(t, filename, `{"name":"updated","level":2}`)
+	assert.NilError(t, w.Reload(context.Background()))
+	assert.Equal(t, watchedConfig{Name: "updated", Level: 2}, *w.Current())
+
+	writeWatchedFile(t, filename, `{"name":"broken"`)
+	assert.Error(t, w.Reload(context.Background()), "parse error")
+	assert.Equal(t, "updated", w.Current().Name)
+
+	writeWatchedFile(t, filename, `{"name":"invalid","level":0}`)
+	assert.ErrorIs(t, w.Reload(context.Background()), validateErr)
+	assert.Equal(t, "updated", w.Current().Name)
+
+	unsubscribe()
+	writeWatchedFile(t, filename, `{"name":"last","level":3}`)
+	assert.NilError(t, w.Reload(context.Background()))
+	assert.Equal(t, "last", w.Current().Name)
+
+	assert.ElementsMatch(t, []string{"initial->updated"}, notified)
+}
+
+func TestWatchPolling(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "config.json")
+	writeWatchedFile(t, filename, `{"name":"initial"}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reloadErrs := make(chan error, 1)
+	w, err := config.Watch[watchedConfig](ctx, config.NewFileProvider(filename, datas.Json()), filename,
+		config.WithPollInterval(5*time.Millisecond),
+		config.WithReloadErrorHandler(func(err error) {
+			select {
+			case reloadErrs <- err:
+			default:
+			}
+		}),
+	)
+	assert.NilError(t, err)
+
+	updated := make(chan *watchedConfig, 1)
+	w.Subscribe(func(old, new *watchedConfig) {
+		select {
+		case updated <- new:
+		default:
+		}
+	})
+
+	writeWatchedFile(t, filename, `{"name":"invalid"`)
+	select {
+	case err := <-reloadErrs:
+		assert.Error(t, err)
+		assert.Equal(t, "initial", w.Current().Name)
+	case <-time.After(time.Second):
+		t.Fatal("modified file was not reloaded")
+	}
+
+	writeWatchedFile(t, filename, `{"name":"polled"}`)
+	select {
+	case v := <-updated:
+		assert.Equal(t, "polled", v.Name)
+		assert.Equal(t, v, w.Current())
+	case <-time.After(time.Second):
+		t.Fatal("modified file was not reloaded")
+	}
+}
+
+func TestWatchInitialError(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "missing.json")
+
+	w, err := config.Watch[watchedConfig](context.Background(), config.NewFileProvider(filename, datas.Json()), filename)
+
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	assert.Equal(t, (*config.Watcher[watchedConfig])(nil), w)
+}
+
+func TestWatchInvalidPollInterval(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "config.json")
+	writeWatchedFile(t, filename, `{"name":"initial","level":1}`)
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		w, err := config.Watch[watchedConfig](context.Background(), config.NewFileProvider(filename, datas.Json()), filename,
+			config.WithPollInterval(interval),
+		)
+
+		assert.ErrorIs(t, err, config.ErrInvalidPollInterval)
+		assert.Equal(t, (*config.Watcher[watchedConfig])(nil), w)
+	}
+}
@@ -2,7 +2,7 @@
 // These interfaces and functions provide a flexible and extensible way to work with structured data in Go,
 // whether it is being serialized to a byte slice, written to an IO stream, or both.
 // In addition to these interfaces, this package also provides a number of useful functions for working with data in Go,
-// including functions for encoding and decoding data using common serialization formats like JSON and XML.
+// including functions for encoding and decoding data using common serialization formats like JSON, XML and YAML.
 package datas
 
 // ByteFormatter is an interface that combines Marshaler and Unmarshaler into a single interface
@@ -7,6 +7,7 @@ import (
 
 var (
 	_ ByteIOFormatter = &jsonData{}
+	_ FieldTagger     = &jsonData{}
 )
 
 type jsonData struct{}
@@ -32,3 +33,7 @@ func (d *jsonData) Unmarshal(data []byte, v any) error {
 func (*jsonData) UnmarshalFrom(r io.Reader, v any) error {
 	return json.NewDecoder(r).Decode(v)
 }
+
+func (*jsonData) FieldTags() []string {
+	return []string{"json"}
+}
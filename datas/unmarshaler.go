@@ -34,3 +34,10 @@ type ReaderUnmarshalerFunc func(r io.Reader, v any) error
 func (f ReaderUnmarshalerFunc) UnmarshalFrom(r io.Reader, v any) error {
 	return f(r, v)
 }
+
+// FieldTagger is an interface for formatters which match struct fields with data keys by struct tags.
+type FieldTagger interface {
+	// FieldTags returns names of struct tags naming fields in order of precedence. The first tag present
+	// on the field is used and field name is used if the tag has no name.
+	FieldTags() []string
+}
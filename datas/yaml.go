@@ -0,0 +1,86 @@
+package datas
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+var (
+	_ ByteIOFormatter = &yamlData{}
+	_ FieldTagger     = &yamlData{}
+)
+
+var (
+	ErrInvalidYaml         = errors.New("invalid yaml")
+	ErrYamlNonPointer      = errors.New("cannot unmarshal yaml to non pointer value")
+	ErrYamlTypeMismatch    = errors.New("cannot unmarshal yaml value to target type")
+	ErrYamlUnknownAlias    = errors.New("unknown yaml alias")
+	ErrYamlUnsupportedType = errors.New("cannot marshal value of unsupported type to yaml")
+)
+
+func wrapErrInvalidYaml(line int, msg string) error {
+	return fmt.Errorf("line %v: %v: %w", line, msg, ErrInvalidYaml)
+}
+
+type yamlData struct{}
+
+// Yaml returns a ByteIOFormatter for encoding and decoding data in YAML format.
+// The returned ByteIOFormatter is implemented without external dependencies and supports practical YAML subset
+// used in configuration files - block and flow mappings and sequences, plain, quoted and block (| and >) scalars,
+// comments, anchors, aliases and << merge keys. Only the first document of the stream is decoded.
+// Struct fields are matched by `yaml` tag name with fallback to `json` tag name and field name compared
+// case-insensitively, so structs prepared for Json() can be used as they are. Values are decoded into existing
+// ones, so decoding into already filled struct overrides only fields present in the document. Encoding.TextUnmarshaler
+// types are decoded from strings and time.Duration is decoded from string like "15s" or number of nanoseconds.
+func Yaml() ByteIOFormatter {
+	return &yamlData{}
+}
+
+func (d *yamlData) Marshal(v any) ([]byte, error) {
+	node, err := encodeYamlValue(reflect.ValueOf(v))
+	if err != nil {
+		return nil, err
+	}
+	return emitYaml(node), nil
+}
+
+func (d *yamlData) MarshalTo(w io.Writer, v any) error {
+	b, err := d.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(b)
+	return err
+}
+
+func (d *yamlData) Unmarshal(data []byte, v any) error {
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Pointer || val.IsNil() {
+		return ErrYamlNonPointer
+	}
+
+	node, err := parseYaml(data)
+	if err != nil {
+		return err
+	}
+
+	if node == nil {
+		return nil
+	}
+	return decodeYamlNode(node, val.Elem())
+}
+
+func (d *yamlData) UnmarshalFrom(r io.Reader, v any) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return d.Unmarshal(data, v)
+}
+
+func (d *yamlData) FieldTags() []string {
+	return []string{"yaml", "json"}
+}
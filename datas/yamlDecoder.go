@@ -0,0 +1,367 @@
+package datas
+
+import (
+	"encoding"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	durationType        = reflect.TypeOf(time.Duration(0))
+)
+
+// yamlField is a struct field matched with mapping key.
+type yamlField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// yamlFieldsOf returns fields of t struct with names taken from `yaml` tag, `json` tag or field name. Fields of
+// embedded structs without name and fields tagged with inline option are promoted unless t has field with the same name.
+func yamlFieldsOf(t reflect.Type) []yamlField {
+	var fields, promoted []yamlField
+	names := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, ok := sf.Tag.Lookup("yaml")
+		if !ok {
+			tag = sf.Tag.Get("json")
+		}
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		optList := strings.Split(opts, ",")
+
+		fieldType := sf.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		inline := containsString(optList, "inline") || (sf.Anonymous && name == "")
+		if inline && fieldType.Kind() == reflect.Struct {
+			for _, f := range yamlFieldsOf(fieldType) {
+				f.index = append([]int{i}, f.index...)
+				promoted = append(promoted, f)
+			}
+			continue
+		}
+
+		if !sf.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = sf.Name
+		}
+
+		names[name] = true
+		fields = append(fields, yamlField{
+			name:      name,
+			index:     []int{i},
+			omitEmpty: containsString(optList, "omitempty"),
+		})
+	}
+
+	for _, f := range promoted {
+		if !names[f.name] {
+			names[f.name] = true
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// findYamlField returns field with exact name or field with name equal under case-folding.
+func findYamlField(fields []yamlField, name string) (yamlField, bool) {
+	for _, f := range fields {
+		if f.name == name {
+			return f, true
+		}
+	}
+
+	for _, f := range fields {
+		if strings.EqualFold(f.name, name) {
+			return f, true
+		}
+	}
+	return yamlField{}, false
+}
+
+// yamlFieldValue returns val field at index. Nil embedded pointers are allocated when alloc is true, otherwise
+// false is returned if the field cannot be reached.
+func yamlFieldValue(val reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && val.Kind() == reflect.Pointer {
+			if val.IsNil() {
+				if !alloc || !val.CanSet() {
+					return reflect.Value{}, false
+				}
+				val.Set(reflect.New(val.Type().Elem()))
+			}
+			val = val.Elem()
+		}
+		val = val.Field(x)
+	}
+	return val, true
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func wrapErrYamlTypeMismatch(node *yamlNode, t reflect.Type) error {
+	return fmt.Errorf("line %v: cannot unmarshal %v into %v: %w", node.line, node.kindName(), t, ErrYamlTypeMismatch)
+}
+
+// decodeYamlNode stores node value in val. Values are decoded into existing ones, so only struct fields
+// and map entries present in node are overridden.
+func decodeYamlNode(node *yamlNode, val reflect.Value) error {
+	if node.isNull() {
+		switch val.Kind() {
+		case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+			val.Set(reflect.Zero(val.Type()))
+		}
+		return nil
+	}
+
+	if val.Kind() == reflect.Pointer {
+		if val.IsNil() {
+			val.Set(reflect.New(val.Type().Elem()))
+		}
+		return decodeYamlNode(node, val.Elem())
+	}
+
+	if node.kind == yamlScalar && reflect.PointerTo(val.Type()).Implements(textUnmarshalerType) {
+		u := val.Addr().Interface().(encoding.TextUnmarshaler)
+		if err := u.UnmarshalText([]byte(node.value)); err != nil {
+			return fmt.Errorf("line %v: %w", node.line, err)
+		}
+		return nil
+	}
+
+	switch val.Kind() {
+	case reflect.Interface:
+		if val.NumMethod() != 0 {
+			return wrapErrYamlTypeMismatch(node, val.Type())
+		}
+		val.Set(reflect.ValueOf(yamlInterfaceValue(node)))
+		return nil
+	case reflect.Struct:
+		if node.kind != yamlMapping {
+			return wrapErrYamlTypeMismatch(node, val.Type())
+		}
+		return decodeYamlStruct(node, val)
+	case reflect.Map:
+		if node.kind != yamlMapping {
+			return wrapErrYamlTypeMismatch(node, val.Type())
+		}
+		return decodeYamlMap(node, val)
+	case reflect.Slice:
+		if node.kind == yamlScalar && val.Type().Elem().Kind() == reflect.Uint8 {
+			b, err := base64.StdEncoding.DecodeString(node.value)
+			if err != nil {
+				return fmt.Errorf("line %v: %w", node.line, err)
+			}
+			val.SetBytes(b)
+			return nil
+		}
+		if node.kind != yamlSequence {
+			return wrapErrYamlTypeMismatch(node, val.Type())
+		}
+
+		slice := reflect.MakeSlice(val.Type(), len(node.values), len(node.values))
+		for i, item := range node.values {
+			if err := decodeYamlNode(item, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		val.Set(slice)
+		return nil
+	case reflect.Array:
+		if node.kind != yamlSequence {
+			return wrapErrYamlTypeMismatch(node, val.Type())
+		}
+
+		val.Set(reflect.Zero(val.Type()))
+		for i := 0; i < len(node.values) && i < val.Len(); i++ {
+			if err := decodeYamlNode(node.values[i], val.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if node.kind != yamlScalar {
+		return wrapErrYamlTypeMismatch(node, val.Type())
+	}
+	return decodeYamlScalar(node, val)
+}
+
+func decodeYamlStruct(node *yamlNode, val reflect.Value) error {
+	fields := yamlFieldsOf(val.Type())
+	for i, key := range node.keys {
+		f, ok := findYamlField(fields, key.value)
+		if !ok {
+			continue
+		}
+
+		field, ok := yamlFieldValue(val, f.index, true)
+		if !ok {
+			continue
+		}
+
+		if err := decodeYamlNode(node.values[i], field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeYamlMap(node *yamlNode, val reflect.Value) error {
+	mapType := val.Type()
+	if val.IsNil() {
+		val.Set(reflect.MakeMap(mapType))
+	}
+
+	for i, keyNode := range node.keys {
+		key := reflect.New(mapType.Key()).Elem()
+		if err := decodeYamlNode(keyNode, key); err != nil {
+			return err
+		}
+
+		// existing entry is decoded into, so nested values are merged
+		elem := reflect.New(mapType.Elem()).Elem()
+		if existing := val.MapIndex(key); existing.IsValid() {
+			elem.Set(existing)
+		}
+
+		if err := decodeYamlNode(node.values[i], elem); err != nil {
+			return err
+		}
+		val.SetMapIndex(key, elem)
+	}
+	return nil
+}
+
+func decodeYamlScalar(node *yamlNode, val reflect.Value) error {
+	s := node.value
+	switch val.Kind() {
+	case reflect.String:
+		val.SetString(s)
+		return nil
+	case reflect.Bool:
+		if b, ok := parseYamlBool(s); ok {
+			val.SetBool(b)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i, err := strconv.ParseInt(s, 0, val.Type().Bits()); err == nil {
+			val.SetInt(i)
+			return nil
+		}
+		if val.Type() == durationType {
+			if d, err := time.ParseDuration(s); err == nil {
+				val.SetInt(int64(d))
+				return nil
+			}
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if u, err := strconv.ParseUint(s, 0, val.Type().Bits()); err == nil {
+			val.SetUint(u)
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if f, ok := parseYamlFloat(s, val.Type().Bits()); ok {
+			val.SetFloat(f)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("line %v: cannot unmarshal '%v' into %v: %w", node.line, s, val.Type(), ErrYamlTypeMismatch)
+}
+
+// yamlInterfaceValue returns node as map[string]any, []any, nil, bool, int, float64 or string.
+func yamlInterfaceValue(node *yamlNode) any {
+	switch node.kind {
+	case yamlMapping:
+		m := make(map[string]any, len(node.keys))
+		for i, k := range node.keys {
+			m[k.value] = yamlInterfaceValue(node.values[i])
+		}
+		return m
+	case yamlSequence:
+		items := make([]any, len(node.values))
+		for i, item := range node.values {
+			items[i] = yamlInterfaceValue(item)
+		}
+		return items
+	}
+
+	if node.quoted {
+		return node.value
+	}
+
+	if isYamlNull(node.value) {
+		return nil
+	}
+
+	if b, ok := parseYamlBool(node.value); ok {
+		return b
+	}
+
+	if i, err := strconv.ParseInt(node.value, 0, 64); err == nil && i >= math.MinInt && i <= math.MaxInt {
+		return int(i)
+	}
+
+	if f, ok := parseYamlFloat(node.value, 64); ok {
+		return f
+	}
+
+	return node.value
+}
+
+func parseYamlBool(s string) (bool, bool) {
+	switch s {
+	case "true", "True", "TRUE":
+		return true, true
+	case "false", "False", "FALSE":
+		return false, true
+	}
+	return false, false
+}
+
+// parseYamlFloat parses number including .inf, -.inf and .nan values.
+func parseYamlFloat(s string, bitSize int) (float64, bool) {
+	switch strings.ToLower(s) {
+	case ".inf", "+.inf":
+		return math.Inf(1), true
+	case "-.inf":
+		return math.Inf(-1), true
+	case ".nan":
+		return math.NaN(), true
+	}
+
+	// Go specific forms like "Inf" or "0x1p-2" are not YAML numbers
+	if strings.ContainsAny(s, "iInNxXpP") {
+		return 0, false
+	}
+
+	f, err := strconv.ParseFloat(strings.ReplaceAll(s, "_", ""), bitSize)
+	return f, err == nil
+}
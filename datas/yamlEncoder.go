@@ -0,0 +1,289 @@
+package datas
+
+import (
+	"encoding"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// encodeYamlValue returns node representing v. Strings are stored as quoted scalars and the emitter
+// adds quotes only if they're needed.
+func encodeYamlValue(v reflect.Value) (*yamlNode, error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return &yamlNode{kind: yamlScalar, value: "null"}, nil
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return &yamlNode{kind: yamlScalar, value: "null"}, nil
+	}
+
+	if v.Type() == durationType {
+		return &yamlNode{kind: yamlScalar, value: time.Duration(v.Int()).String(), quoted: true}, nil
+	}
+
+	if reflect.PointerTo(v.Type()).Implements(textMarshalerType) {
+		// pointer method set contains methods with value receiver too
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		b, err := ptr.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		return &yamlNode{kind: yamlScalar, value: string(b), quoted: true}, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return &yamlNode{kind: yamlScalar, value: strconv.FormatBool(v.Bool())}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &yamlNode{kind: yamlScalar, value: strconv.FormatInt(v.Int(), 10)}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &yamlNode{kind: yamlScalar, value: strconv.FormatUint(v.Uint(), 10)}, nil
+	case reflect.Float32, reflect.Float64:
+		return &yamlNode{kind: yamlScalar, value: formatYamlFloat(v.Float(), v.Type().Bits())}, nil
+	case reflect.String:
+		return &yamlNode{kind: yamlScalar, value: v.String(), quoted: true}, nil
+	case reflect.Struct:
+		return encodeYamlStruct(v)
+	case reflect.Map:
+		if v.IsNil() {
+			return &yamlNode{kind: yamlScalar, value: "null"}, nil
+		}
+		return encodeYamlMap(v)
+	case reflect.Slice:
+		if v.IsNil() {
+			return &yamlNode{kind: yamlScalar, value: "null"}, nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return &yamlNode{kind: yamlScalar, value: base64.StdEncoding.EncodeToString(v.Bytes()), quoted: true}, nil
+		}
+		return encodeYamlSequence(v)
+	case reflect.Array:
+		return encodeYamlSequence(v)
+	}
+
+	return nil, fmt.Errorf("type %v: %w", v.Type(), ErrYamlUnsupportedType)
+}
+
+func encodeYamlStruct(v reflect.Value) (*yamlNode, error) {
+	node := &yamlNode{kind: yamlMapping}
+	for _, f := range yamlFieldsOf(v.Type()) {
+		field, ok := yamlFieldValue(v, f.index, false)
+		if !ok || (f.omitEmpty && isEmptyYamlValue(field)) {
+			continue
+		}
+
+		value, err := encodeYamlValue(field)
+		if err != nil {
+			return nil, err
+		}
+
+		node.keys = append(node.keys, &yamlNode{kind: yamlScalar, value: f.name, quoted: true})
+		node.values = append(node.values, value)
+	}
+	return node, nil
+}
+
+func encodeYamlMap(v reflect.Value) (*yamlNode, error) {
+	type entry struct {
+		key   string
+		value reflect.Value
+	}
+
+	entries := make([]entry, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		key, err := encodeYamlValue(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if key.kind != yamlScalar {
+			return nil, fmt.Errorf("map key type %v: %w", v.Type().Key(), ErrYamlUnsupportedType)
+		}
+		entries = append(entries, entry{key: key.value, value: iter.Value()})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key < entries[j].key
+	})
+
+	node := &yamlNode{kind: yamlMapping}
+	for _, e := range entries {
+		value, err := encodeYamlValue(e.value)
+		if err != nil {
+			return nil, err
+		}
+
+		node.keys = append(node.keys, &yamlNode{kind: yamlScalar, value: e.key, quoted: true})
+		node.values = append(node.values, value)
+	}
+	return node, nil
+}
+
+func encodeYamlSequence(v reflect.Value) (*yamlNode, error) {
+	node := &yamlNode{kind: yamlSequence}
+	for i := 0; i < v.Len(); i++ {
+		item, err := encodeYamlValue(v.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		node.values = append(node.values, item)
+	}
+	return node, nil
+}
+
+// isEmptyYamlValue reports whether v is empty value omitted with omitempty option.
+func isEmptyYamlValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+	return false
+}
+
+func formatYamlFloat(f float64, bitSize int) string {
+	switch {
+	case math.IsInf(f, 1):
+		return ".inf"
+	case math.IsInf(f, -1):
+		return "-.inf"
+	case math.IsNaN(f):
+		return ".nan"
+	}
+	return strconv.FormatFloat(f, 'g', -1, bitSize)
+}
+
+// emitYaml returns YAML document of node in block style.
+func emitYaml(node *yamlNode) []byte {
+	var b strings.Builder
+	if isYamlBlockNode(node) {
+		writeYamlBlock(&b, node, 0)
+	} else {
+		b.WriteString(yamlInline(node))
+		b.WriteByte('\n')
+	}
+	return []byte(b.String())
+}
+
+// isYamlBlockNode reports whether node is written in block style - it's not empty mapping or sequence.
+func isYamlBlockNode(node *yamlNode) bool {
+	return node.kind != yamlScalar && len(node.values) > 0
+}
+
+// yamlInline returns scalar or empty collection written in flow style.
+func yamlInline(node *yamlNode) string {
+	switch node.kind {
+	case yamlMapping:
+		return "{}"
+	case yamlSequence:
+		return "[]"
+	}
+
+	if node.quoted {
+		return formatYamlString(node.value)
+	}
+	return node.value
+}
+
+func writeYamlBlock(b *strings.Builder, node *yamlNode, indent int) {
+	pad := strings.Repeat(" ", indent)
+	if node.kind == yamlMapping {
+		for i, key := range node.keys {
+			b.WriteString(pad)
+			b.WriteString(formatYamlString(key.value))
+			b.WriteString(":")
+
+			value := node.values[i]
+			if isYamlBlockNode(value) {
+				b.WriteString("\n")
+				writeYamlBlock(b, value, indent+2)
+			} else {
+				b.WriteString(" ")
+				b.WriteString(yamlInline(value))
+				b.WriteString("\n")
+			}
+		}
+		return
+	}
+
+	for _, item := range node.values {
+		b.WriteString(pad)
+		b.WriteString("- ")
+		if isYamlBlockNode(item) {
+			// the first line of nested collection is written after the dash
+			var nested strings.Builder
+			writeYamlBlock(&nested, item, indent+2)
+			b.WriteString(nested.String()[indent+2:])
+		} else {
+			b.WriteString(yamlInline(item))
+			b.WriteString("\n")
+		}
+	}
+}
+
+// formatYamlString returns s as plain scalar or double-quoted one if it would be parsed as a different value.
+func formatYamlString(s string) string {
+	if needsYamlQuotes(s) {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+func needsYamlQuotes(s string) bool {
+	if s == "" || strings.TrimSpace(s) != s || isYamlNull(s) {
+		return true
+	}
+
+	if _, ok := parseYamlBool(s); ok {
+		return true
+	}
+
+	switch strings.ToLower(s) {
+	case "yes", "no", "on", "off", "y", "n":
+		// boolean values in YAML 1.1
+		return true
+	}
+
+	if _, err := strconv.ParseInt(s, 0, 64); err == nil {
+		return true
+	}
+
+	if _, ok := parseYamlFloat(s, 64); ok {
+		return true
+	}
+
+	if strings.ContainsRune("-?:,[]{}#&*!|>'\"%@`", rune(s[0])) {
+		return true
+	}
+
+	if strings.Contains(s, ": ") || strings.Contains(s, " #") || strings.HasSuffix(s, ":") {
+		return true
+	}
+
+	for _, r := range s {
+		if r != ' ' && !unicode.IsPrint(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,812 @@
+package datas
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type yamlKind int
+
+const (
+	yamlScalar yamlKind = iota
+	yamlMapping
+	yamlSequence
+)
+
+// yamlNode is a parsed YAML value. Mapping keys are stored in keys and their values at the same index
+// in values. Sequence items are stored in values.
+type yamlNode struct {
+	kind   yamlKind
+	line   int
+	value  string
+	quoted bool
+	keys   []*yamlNode
+	values []*yamlNode
+}
+
+// isNull reports whether node is plain null scalar.
+func (n *yamlNode) isNull() bool {
+	return n.kind == yamlScalar && !n.quoted && isYamlNull(n.value)
+}
+
+// index returns index of key in mapping node or -1 if there is no such key.
+func (n *yamlNode) index(key string) int {
+	for i, k := range n.keys {
+		if k.value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// merge adds pairs of mapping m or each mapping of sequence m which keys are not present in n.
+func (n *yamlNode) merge(m *yamlNode) error {
+	switch m.kind {
+	case yamlMapping:
+		for i, k := range m.keys {
+			if n.index(k.value) < 0 {
+				n.keys = append(n.keys, k)
+				n.values = append(n.values, m.values[i])
+			}
+		}
+		return nil
+	case yamlSequence:
+		for _, item := range m.values {
+			if item.kind != yamlMapping {
+				return wrapErrInvalidYaml(item.line, "merge key value must be a mapping")
+			}
+			if err := n.merge(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return wrapErrInvalidYaml(m.line, "merge key value must be a mapping")
+}
+
+func (n *yamlNode) kindName() string {
+	switch n.kind {
+	case yamlMapping:
+		return "mapping"
+	case yamlSequence:
+		return "sequence"
+	}
+	return "scalar"
+}
+
+func isYamlNull(s string) bool {
+	switch s {
+	case "", "~", "null", "Null", "NULL":
+		return true
+	}
+	return false
+}
+
+// yamlParser parses block structure of YAML document line by line.
+type yamlParser struct {
+	lines   []string
+	pos     int
+	anchors map[string]*yamlNode
+}
+
+// parseYaml returns root node of the first document in data or nil if the document is empty.
+func parseYaml(data []byte) (*yamlNode, error) {
+	text := strings.TrimPrefix(strings.ReplaceAll(string(data), "\r\n", "\n"), "\ufeff")
+
+	p := &yamlParser{anchors: make(map[string]*yamlNode)}
+	started := false
+	for _, line := range strings.Split(text, "\n") {
+		if isYamlMarker(line, "---") {
+			if started {
+				break
+			}
+			started = true
+			p.lines = append(p.lines, "")
+			continue
+		}
+
+		if isYamlMarker(line, "...") {
+			break
+		}
+
+		if !started && strings.HasPrefix(line, "%") {
+			// directives are ignored
+			p.lines = append(p.lines, "")
+			continue
+		}
+
+		if !isYamlBlank(line) {
+			started = true
+		}
+		p.lines = append(p.lines, line)
+	}
+
+	p.skipBlank()
+	if p.pos == len(p.lines) {
+		return nil, nil
+	}
+
+	node, err := p.parseBlock(-1, false)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipBlank()
+	if p.pos < len(p.lines) {
+		return nil, wrapErrInvalidYaml(p.pos+1, "unexpected content")
+	}
+
+	return node, nil
+}
+
+func (p *yamlParser) skipBlank() {
+	for p.pos < len(p.lines) && isYamlBlank(p.lines[p.pos]) {
+		p.pos++
+	}
+}
+
+// indent returns number of leading spaces of line. Tabs cannot be used for indentation.
+func (p *yamlParser) indent(line string) (int, error) {
+	n := len(line) - len(strings.TrimLeft(line, " "))
+	if n < len(line) && line[n] == '\t' {
+		return 0, wrapErrInvalidYaml(p.pos+1, "tabs cannot be used for indentation")
+	}
+	return n, nil
+}
+
+// parseBlock parses node starting at the current line which is indented more than parentIndent. If allowSequence
+// is true, sequence can be indented the same as parent. If there is no such node, null scalar is returned.
+func (p *yamlParser) parseBlock(parentIndent int, allowSequence bool) (*yamlNode, error) {
+	p.skipBlank()
+	if p.pos == len(p.lines) {
+		return &yamlNode{kind: yamlScalar, line: p.pos}, nil
+	}
+
+	line := p.lines[p.pos]
+	indent, err := p.indent(line)
+	if err != nil {
+		return nil, err
+	}
+
+	text := stripYamlComment(line[indent:])
+	isItem := isYamlSequenceItem(text)
+	if indent < parentIndent || (indent == parentIndent && !(allowSequence && isItem)) {
+		return &yamlNode{kind: yamlScalar, line: p.pos}, nil
+	}
+
+	switch {
+	case isItem:
+		return p.parseSequence(indent)
+	case findYamlMappingColon(text) >= 0:
+		return p.parseMapping(indent)
+	}
+
+	return p.parseValue(text, parentIndent, false)
+}
+
+// parseSequence parses block sequence which items start with "- " at indent.
+func (p *yamlParser) parseSequence(indent int) (*yamlNode, error) {
+	node := &yamlNode{kind: yamlSequence, line: p.pos + 1}
+	for {
+		p.skipBlank()
+		if p.pos == len(p.lines) {
+			break
+		}
+
+		line := p.lines[p.pos]
+		lineIndent, err := p.indent(line)
+		if err != nil {
+			return nil, err
+		}
+
+		if lineIndent > indent {
+			return nil, wrapErrInvalidYaml(p.pos+1, "unexpected indentation")
+		}
+
+		if lineIndent < indent || !isYamlSequenceItem(stripYamlComment(line[lineIndent:])) {
+			break
+		}
+
+		// dash is replaced with space, so item content is parsed as node indented more than the sequence
+		p.lines[p.pos] = line[:indent] + " " + line[indent+1:]
+		item, err := p.parseBlock(indent, false)
+		if err != nil {
+			return nil, err
+		}
+		node.values = append(node.values, item)
+	}
+
+	return node, nil
+}
+
+// parseMapping parses block mapping which keys are at indent.
+func (p *yamlParser) parseMapping(indent int) (*yamlNode, error) {
+	node := &yamlNode{kind: yamlMapping, line: p.pos + 1}
+	var merges []*yamlNode
+	for {
+		p.skipBlank()
+		if p.pos == len(p.lines) {
+			break
+		}
+
+		line := p.lines[p.pos]
+		lineIndent, err := p.indent(line)
+		if err != nil {
+			return nil, err
+		}
+
+		if lineIndent < indent {
+			break
+		}
+
+		if lineIndent > indent {
+			return nil, wrapErrInvalidYaml(p.pos+1, "unexpected indentation")
+		}
+
+		text := stripYamlComment(line[indent:])
+		colon := findYamlMappingColon(text)
+		if colon < 0 {
+			return nil, wrapErrInvalidYaml(p.pos+1, "expected mapping key")
+		}
+
+		key, err := parseYamlScalar(strings.TrimSpace(text[:colon]), p.pos+1)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := p.parseValue(strings.TrimSpace(text[colon+1:]), indent, true)
+		if err != nil {
+			return nil, err
+		}
+
+		if key.value == "<<" && !key.quoted {
+			merges = append(merges, value)
+			continue
+		}
+
+		if node.index(key.value) >= 0 {
+			return nil, wrapErrInvalidYaml(key.line, fmt.Sprintf("duplicated key '%v'", key.value))
+		}
+
+		// keys are decoded as strings, so plain null or number key is not converted
+		key.quoted = true
+		node.keys = append(node.keys, key)
+		node.values = append(node.values, value)
+	}
+
+	for _, m := range merges {
+		if err := node.merge(m); err != nil {
+			return nil, err
+		}
+	}
+
+	return node, nil
+}
+
+// parseValue parses value which starts with text at the current line. Nested block value must be indented
+// more than ownerIndent or the same if allowSequence is true and the value is a sequence.
+func (p *yamlParser) parseValue(text string, ownerIndent int, allowSequence bool) (*yamlNode, error) {
+	line := p.pos + 1
+
+	var anchor string
+	if strings.HasPrefix(text, "&") {
+		anchor, text, _ = strings.Cut(text[1:], " ")
+		text = strings.TrimSpace(text)
+		if anchor == "" {
+			return nil, wrapErrInvalidYaml(line, "empty anchor name")
+		}
+	}
+
+	var node *yamlNode
+	var err error
+	switch {
+	case text == "":
+		p.pos++
+		node, err = p.parseBlock(ownerIndent, allowSequence)
+	case text[0] == '|' || text[0] == '>':
+		p.pos++
+		node, err = p.parseBlockScalar(text, ownerIndent, line)
+	case text[0] == '[' || text[0] == '{':
+		node, err = p.parseFlow(text)
+	case text[0] == '*':
+		p.pos++
+		node, err = p.alias(strings.TrimSpace(text[1:]), line)
+	default:
+		p.pos++
+		node, err = parseYamlScalar(text, line)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if anchor != "" {
+		p.anchors[anchor] = node
+	}
+	return node, nil
+}
+
+func (p *yamlParser) alias(name string, line int) (*yamlNode, error) {
+	node, ok := p.anchors[name]
+	if !ok {
+		return nil, fmt.Errorf("line %v: alias '%v': %w", line, name, ErrYamlUnknownAlias)
+	}
+	return node, nil
+}
+
+// parseBlockScalar parses literal (|) or folded (>) scalar with header containing optional chomping
+// and indentation indicators. Content lines are the following lines indented more than ownerIndent.
+func (p *yamlParser) parseBlockScalar(header string, ownerIndent int, line int) (*yamlNode, error) {
+	literal := header[0] == '|'
+	var chomping rune
+	contentIndent := -1
+	for _, c := range header[1:] {
+		switch {
+		case c == '-' || c == '+':
+			chomping = c
+		case c >= '1' && c <= '9':
+			contentIndent = int(c - '0')
+			if ownerIndent > 0 {
+				contentIndent += ownerIndent
+			}
+		default:
+			return nil, wrapErrInvalidYaml(line, "invalid block scalar header")
+		}
+	}
+
+	var lines []string
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if strings.TrimSpace(l) == "" {
+			lines = append(lines, "")
+			p.pos++
+			continue
+		}
+
+		indent := len(l) - len(strings.TrimLeft(l, " "))
+		if contentIndent < 0 {
+			if indent <= ownerIndent {
+				break
+			}
+			contentIndent = indent
+		}
+
+		if indent < contentIndent {
+			break
+		}
+
+		lines = append(lines, l[contentIndent:])
+		p.pos++
+	}
+
+	end := len(lines)
+	for end > 0 && lines[end-1] == "" {
+		end--
+	}
+	content, trailing := lines[:end], len(lines)-end
+
+	var s string
+	if literal {
+		s = strings.Join(content, "\n")
+	} else {
+		s = foldYamlLines(content)
+	}
+
+	switch {
+	case chomping == '+' && len(content) > 0:
+		s += strings.Repeat("\n", trailing+1)
+	case chomping == '+':
+		s += strings.Repeat("\n", trailing)
+	case chomping != '-' && len(content) > 0:
+		s += "\n"
+	}
+
+	return &yamlNode{kind: yamlScalar, line: line, value: s, quoted: true}, nil
+}
+
+// foldYamlLines joins lines with space. Empty lines are replaced with line breaks and more indented lines
+// keep their line breaks.
+func foldYamlLines(lines []string) string {
+	var b strings.Builder
+	for i := 0; i < len(lines); {
+		prev := lines[i]
+		b.WriteString(prev)
+		i++
+
+		blanks := 0
+		for i < len(lines) && lines[i] == "" {
+			blanks++
+			i++
+		}
+
+		if i == len(lines) {
+			break
+		}
+
+		switch {
+		case blanks > 0:
+			b.WriteString(strings.Repeat("\n", blanks))
+		case isYamlMoreIndented(prev) || isYamlMoreIndented(lines[i]):
+			b.WriteString("\n")
+		default:
+			b.WriteString(" ")
+		}
+	}
+	return b.String()
+}
+
+func isYamlMoreIndented(line string) bool {
+	return strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")
+}
+
+// parseFlow parses flow collection starting with text. Collection can span multiple lines.
+func (p *yamlParser) parseFlow(text string) (*yamlNode, error) {
+	line := p.pos + 1
+	p.pos++
+	for yamlFlowDepth(text) > 0 && p.pos < len(p.lines) {
+		next := p.lines[p.pos]
+		p.pos++
+		if !isYamlBlank(next) {
+			text += " " + stripYamlComment(strings.TrimSpace(next))
+		}
+	}
+
+	s := &yamlFlowScanner{parser: p, text: text, line: line}
+	node, err := s.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	s.skipSpaces()
+	if s.pos < len(s.text) {
+		return nil, wrapErrInvalidYaml(line, "unexpected content after flow collection")
+	}
+	return node, nil
+}
+
+// yamlFlowScanner parses flow collections, e.g. [a, b] or {a: 1, b: [c]}.
+type yamlFlowScanner struct {
+	parser *yamlParser
+	text   string
+	pos    int
+	line   int
+}
+
+func (s *yamlFlowScanner) skipSpaces() {
+	for s.pos < len(s.text) && (s.text[s.pos] == ' ' || s.text[s.pos] == '\t') {
+		s.pos++
+	}
+}
+
+func (s *yamlFlowScanner) peek() byte {
+	if s.pos < len(s.text) {
+		return s.text[s.pos]
+	}
+	return 0
+}
+
+func (s *yamlFlowScanner) parseValue() (*yamlNode, error) {
+	s.skipSpaces()
+
+	var anchor string
+	if s.peek() == '&' {
+		s.pos++
+		anchor = s.readName()
+		if anchor == "" {
+			return nil, wrapErrInvalidYaml(s.line, "empty anchor name")
+		}
+		s.skipSpaces()
+	}
+
+	var node *yamlNode
+	var err error
+	switch s.peek() {
+	case '[':
+		node, err = s.parseSequence()
+	case '{':
+		node, err = s.parseMapping()
+	case '*':
+		s.pos++
+		node, err = s.parser.alias(s.readName(), s.line)
+	case '"', '\'':
+		var value string
+		var n int
+		value, n, err = unquoteYaml(s.text[s.pos:], s.line)
+		s.pos += n
+		node = &yamlNode{kind: yamlScalar, line: s.line, value: value, quoted: true}
+	default:
+		node = &yamlNode{kind: yamlScalar, line: s.line, value: s.readPlain()}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if anchor != "" {
+		s.parser.anchors[anchor] = node
+	}
+	return node, nil
+}
+
+// readName reads anchor or alias name.
+func (s *yamlFlowScanner) readName() string {
+	start := s.pos
+	for s.pos < len(s.text) && !strings.ContainsRune(" \t,[]{}", rune(s.text[s.pos])) {
+		s.pos++
+	}
+	return s.text[start:s.pos]
+}
+
+// readPlain reads plain scalar which ends at flow indicator or colon followed by space.
+func (s *yamlFlowScanner) readPlain() string {
+	start := s.pos
+	for s.pos < len(s.text) {
+		c := s.text[s.pos]
+		if strings.ContainsRune(",[]{}", rune(c)) {
+			break
+		}
+		if c == ':' && (s.pos+1 == len(s.text) || strings.ContainsRune(" \t,[]{}", rune(s.text[s.pos+1]))) {
+			break
+		}
+		s.pos++
+	}
+	return strings.TrimSpace(s.text[start:s.pos])
+}
+
+func (s *yamlFlowScanner) parseSequence() (*yamlNode, error) {
+	node := &yamlNode{kind: yamlSequence, line: s.line}
+	s.pos++
+	for {
+		s.skipSpaces()
+		if s.peek() == ']' {
+			s.pos++
+			return node, nil
+		}
+
+		item, err := s.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		node.values = append(node.values, item)
+
+		if err := s.separator(']'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (s *yamlFlowScanner) parseMapping() (*yamlNode, error) {
+	node := &yamlNode{kind: yamlMapping, line: s.line}
+	var merges []*yamlNode
+	s.pos++
+	for {
+		s.skipSpaces()
+		if s.peek() == '}' {
+			s.pos++
+			break
+		}
+
+		key, err := s.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		if key.kind != yamlScalar {
+			return nil, wrapErrInvalidYaml(s.line, "mapping key must be a scalar")
+		}
+
+		s.skipSpaces()
+		value := &yamlNode{kind: yamlScalar, line: s.line}
+		if s.peek() == ':' {
+			s.pos++
+			if value, err = s.parseValue(); err != nil {
+				return nil, err
+			}
+		}
+
+		if key.value == "<<" && !key.quoted {
+			merges = append(merges, value)
+		} else if node.index(key.value) >= 0 {
+			return nil, wrapErrInvalidYaml(s.line, fmt.Sprintf("duplicated key '%v'", key.value))
+		} else {
+			key.quoted = true
+			node.keys = append(node.keys, key)
+			node.values = append(node.values, value)
+		}
+
+		if err := s.separator('}'); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, m := range merges {
+		if err := node.merge(m); err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+// separator skips comma between collection entries. Closing character is left to be consumed by collection.
+func (s *yamlFlowScanner) separator(closing byte) error {
+	s.skipSpaces()
+	switch s.peek() {
+	case ',':
+		s.pos++
+		return nil
+	case closing:
+		return nil
+	}
+	return wrapErrInvalidYaml(s.line, fmt.Sprintf("expected ',' or '%c' in flow collection", closing))
+}
+
+// parseYamlScalar parses plain, single-quoted or double-quoted scalar.
+func parseYamlScalar(text string, line int) (*yamlNode, error) {
+	if text == "" || (text[0] != '"' && text[0] != '\'') {
+		return &yamlNode{kind: yamlScalar, line: line, value: text}, nil
+	}
+
+	value, n, err := unquoteYaml(text, line)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(text[n:]) != "" {
+		return nil, wrapErrInvalidYaml(line, "unexpected content after quoted scalar")
+	}
+	return &yamlNode{kind: yamlScalar, line: line, value: value, quoted: true}, nil
+}
+
+// unquoteYaml returns value of quoted scalar at the beginning of s and number of consumed bytes.
+func unquoteYaml(s string, line int) (string, int, error) {
+	if s[0] == '\'' {
+		var b strings.Builder
+		for i := 1; i < len(s); i++ {
+			if s[i] != '\'' {
+				b.WriteByte(s[i])
+				continue
+			}
+			if i+1 < len(s) && s[i+1] == '\'' {
+				b.WriteByte('\'')
+				i++
+				continue
+			}
+			return b.String(), i + 1, nil
+		}
+		return "", 0, wrapErrInvalidYaml(line, "unterminated single-quoted scalar")
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			return b.String(), i + 1, nil
+		case '\\':
+			n, err := writeYamlEscape(&b, s[i+1:], line)
+			if err != nil {
+				return "", 0, err
+			}
+			i += n
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", 0, wrapErrInvalidYaml(line, "unterminated double-quoted scalar")
+}
+
+var yamlEscapes = map[byte]string{
+	'0': "\x00", 'a': "\a", 'b': "\b", 't': "\t", '\t': "\t", 'n': "\n", 'v': "\v", 'f': "\f", 'r': "\r",
+	'e': "\x1b", ' ': " ", '"': "\"", '/': "/", '\\': "\\", 'N': "\u0085", '_': "\u00a0", 'L': "\u2028", 'P': "\u2029",
+}
+
+// writeYamlEscape writes character of escape sequence which s starts with (after backslash) and returns its length.
+func writeYamlEscape(b *strings.Builder, s string, line int) (int, error) {
+	if s == "" {
+		return 0, wrapErrInvalidYaml(line, "unterminated escape sequence")
+	}
+
+	if v, ok := yamlEscapes[s[0]]; ok {
+		b.WriteString(v)
+		return 1, nil
+	}
+
+	size := map[byte]int{'x': 2, 'u': 4, 'U': 8}[s[0]]
+	if size == 0 || len(s) < size+1 {
+		return 0, wrapErrInvalidYaml(line, fmt.Sprintf("invalid escape sequence '\\%c'", s[0]))
+	}
+
+	code, err := strconv.ParseUint(s[1:size+1], 16, 32)
+	if err != nil || (size > 2 && !utf8.ValidRune(rune(code))) {
+		return 0, wrapErrInvalidYaml(line, fmt.Sprintf("invalid escape sequence '\\%v'", s[:size+1]))
+	}
+
+	if size == 2 {
+		b.WriteByte(byte(code))
+	} else {
+		b.WriteRune(rune(code))
+	}
+	return size + 1, nil
+}
+
+func isYamlMarker(line, marker string) bool {
+	return line == marker || strings.HasPrefix(line, marker+" ") || strings.HasPrefix(line, marker+"\t")
+}
+
+func isYamlBlank(line string) bool {
+	t := strings.TrimLeft(line, " \t")
+	return t == "" || t[0] == '#'
+}
+
+func isYamlSequenceItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ") || strings.HasPrefix(text, "-\t")
+}
+
+// isYamlQuoteStart reports whether s[i] starts quoted scalar. Quotes inside plain scalars, like in "it's",
+// are not treated as quotes.
+func isYamlQuoteStart(s string, i int) bool {
+	return (s[i] == '"' || s[i] == '\'') && (i == 0 || strings.ContainsRune(" \t[{,:-?", rune(s[i-1])))
+}
+
+// skipYamlQuoted returns index after quoted scalar starting at s[i] or len(s) if it's not terminated.
+func skipYamlQuoted(s string, i int) int {
+	quote := s[i]
+	for i++; i < len(s); i++ {
+		switch {
+		case quote == '"' && s[i] == '\\':
+			i++
+		case s[i] == quote && quote == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			i++
+		case s[i] == quote:
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// stripYamlComment returns s without comment and trailing spaces. Comment starts with # at the beginning
+// or after whitespace outside of quoted scalar.
+func stripYamlComment(s string) string {
+	for i := 0; i < len(s); i++ {
+		switch {
+		case isYamlQuoteStart(s, i):
+			i = skipYamlQuoted(s, i) - 1
+		case s[i] == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			return strings.TrimRight(s[:i], " \t")
+		}
+	}
+	return strings.TrimRight(s, " \t")
+}
+
+// findYamlMappingColon returns index of colon separating mapping key from value in block mapping line or -1
+// if text is not a mapping entry.
+func findYamlMappingColon(text string) int {
+	depth := 0
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; {
+		case isYamlQuoteStart(text, i):
+			i = skipYamlQuoted(text, i) - 1
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+		case c == ':' && depth == 0 && (i+1 == len(text) || text[i+1] == ' ' || text[i+1] == '\t'):
+			return i
+		}
+	}
+	return -1
+}
+
+// yamlFlowDepth returns number of unclosed flow collections in s.
+func yamlFlowDepth(s string) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case isYamlQuoteStart(s, i):
+			i = skipYamlQuoted(s, i) - 1
+		case s[i] == '[' || s[i] == '{':
+			depth++
+		case s[i] == ']' || s[i] == '}':
+			depth--
+		}
+	}
+	return depth
+}
@@ -0,0 +1,450 @@
+package datas_test
+
+import (
+	"bytes"
+	"math"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Prastiwar/Go-flow/datas"
+	"github.com/Prastiwar/Go-flow/tests/assert"
+	"github.com/Prastiwar/Go-flow/tests/mocks"
+)
+
+type yamlDatabase struct {
+	Host    string        `yaml:"host"`
+	Port    int           `json:"port"`
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+	Replica *yamlDatabase `yaml:"replica,omitempty"`
+}
+
+type yamlBase struct {
+	Version string `yaml:"version"`
+}
+
+type yamlConfig struct {
+	yamlBase
+	Name     string                  `yaml:"name"`
+	Enabled  bool                    `yaml:"enabled"`
+	Ratio    float64                 `yaml:"ratio"`
+	Tags     []string                `yaml:"tags"`
+	Ports    []uint16                `yaml:"ports"`
+	Labels   map[string]string       `yaml:"labels"`
+	Database yamlDatabase            `yaml:"database"`
+	Backups  []yamlDatabase          `yaml:"backups"`
+	Limits   map[string]yamlDatabase `yaml:"limits"`
+	IP       net.IP                  `yaml:"ip"`
+	Extra    any                     `yaml:"extra"`
+	Ignored  string                  `yaml:"-"`
+	Untagged string
+}
+
+func TestYamlUnmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		init    func() any
+		want    any
+	}{
+		{
+			name: "success-block",
+			content: `
+# service configuration
+version: "1.0"
+name: service # trailing comment
+enabled: true
+ratio: 0.5
+tags:
+  - api
+  - 'it''s quoted'
+  - "tab\tescaped"
+ports:
+- 80
+- 0x1BB
+labels:
+  env: prod
+  url: http://example.com/#anchor
+database:
+  host: db.local
+  PORT: 5432
+  timeout: 15s
+  replica:
+    host: replica.local
+backups:
+  - host: backup-1
+    port: 1
+  - host: backup-2
+    port: 2
+ip: 127.0.0.1
+untagged: value
+ignored: skipped
+unknown: skipped
+`,
+			init: func() any { return &yamlConfig{} },
+			want: &yamlConfig{
+				yamlBase: yamlBase{Version: "1.0"},
+				Name:     "service",
+				Enabled:  true,
+				Ratio:    0.5,
+				Tags:     []string{"api", "it's quoted", "tab\tescaped"},
+				Ports:    []uint16{80, 443},
+				Labels:   map[string]string{"env": "prod", "url": "http://example.com/#anchor"},
+				Database: yamlDatabase{
+					Host:    "db.local",
+					Port:    5432,
+					Timeout: 15 * time.Second,
+					Replica: &yamlDatabase{Host: "replica.local"},
+				},
+				Backups:  []yamlDatabase{{Host: "backup-1", Port: 1}, {Host: "backup-2", Port: 2}},
+				IP:       net.ParseIP("127.0.0.1"),
+				Untagged: "value",
+			},
+		},
+		{
+			name: "success-flow",
+			content: `---
+tags: [api, "web, ui", ""]
+ports: [
+  80, # http
+  443
+]
+labels: {env: prod, empty: }
+database: {host: db, port: 1, replica: {host: r}}
+...
+name: ignored after document end
+`,
+			init: func() any { return &yamlConfig{} },
+			want: &yamlConfig{
+				Tags:     []string{"api", "web, ui", ""},
+				Ports:    []uint16{80, 443},
+				Labels:   map[string]string{"env": "prod", "empty": ""},
+				Database: yamlDatabase{Host: "db", Port: 1, Replica: &yamlDatabase{Host: "r"}},
+			},
+		},
+		{
+			name: "success-block-scalars",
+			content: `literal: |
+  first line
+    indented line
+
+  last line
+folded: >
+  folded
+  text
+
+  new paragraph
+strip: |-
+  no trailing newline
+
+keep: |+
+  trailing newlines
+
+next: value
+`,
+			init: func() any { return &map[string]string{} },
+			want: &map[string]string{
+				"literal": "first line\n  indented line\n\nlast line\n",
+				"folded":  "folded text\nnew paragraph\n",
+				"strip":   "no trailing newline",
+				"keep":    "trailing newlines\n\n",
+				"next":    "value",
+			},
+		},
+		{
+			name: "success-anchors",
+			content: `defaults: &defaults
+  host: default.local
+  port: 5432
+primary:
+  <<: *defaults
+  host: primary.local
+secondary: *defaults
+names:
+  - &name shared
+  - *name
+`,
+			init: func() any {
+				return &struct {
+					Primary   yamlDatabase
+					Secondary yamlDatabase
+					Names     []string
+				}{}
+			},
+			want: &struct {
+				Primary   yamlDatabase
+				Secondary yamlDatabase
+				Names     []string
+			}{
+				Primary:   yamlDatabase{Host: "primary.local", Port: 5432},
+				Secondary: yamlDatabase{Host: "default.local", Port: 5432},
+				Names:     []string{"shared", "shared"},
+			},
+		},
+		{
+			name: "success-interface",
+			content: `
+string: text
+quoted: "123"
+int: 42
+float: 1.5
+bool: false
+null: ~
+list: [1, two]
+nested:
+  key: value
+`,
+			init: func() any { return &map[string]any{} },
+			want: &map[string]any{
+				"string": "text",
+				"quoted": "123",
+				"int":    42,
+				"float":  1.5,
+				"bool":   false,
+				"null":   nil,
+				"list":   []any{1, "two"},
+				"nested": map[string]any{"key": "value"},
+			},
+		},
+		{
+			name: "success-merge-into-existing",
+			content: `
+database:
+  port: 6543
+limits:
+  a:
+    port: 2
+`,
+			init: func() any {
+				return &yamlConfig{
+					Name:     "kept",
+					Database: yamlDatabase{Host: "kept.local", Port: 5432},
+					Limits:   map[string]yamlDatabase{"a": {Host: "a.local", Port: 1}, "b": {Port: 3}},
+				}
+			},
+			want: &yamlConfig{
+				Name:     "kept",
+				Database: yamlDatabase{Host: "kept.local", Port: 6543},
+				Limits:   map[string]yamlDatabase{"a": {Host: "a.local", Port: 2}, "b": {Port: 3}},
+			},
+		},
+		{
+			name: "success-sequence-of-sequences",
+			content: `
+- - 1
+  - 2
+- [3]
+-
+  - 4
+`,
+			init: func() any { return &[][]int{} },
+			want: &[][]int{{1, 2}, {3}, {4}},
+		},
+		{
+			name:    "success-null-document",
+			content: "# only comment\n",
+			init:    func() any { return &yamlConfig{Name: "kept"} },
+			want:    &yamlConfig{Name: "kept"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := tt.init()
+
+			err := datas.Yaml().Unmarshal([]byte(tt.content), v)
+
+			assert.NilError(t, err)
+			assert.Equal(t, tt.want, v)
+		})
+	}
+}
+
+func TestYamlUnmarshalSpecialFloats(t *testing.T) {
+	var v []float64
+	err := datas.Yaml().Unmarshal([]byte("[.inf, -.inf, .nan, 1e3]"), &v)
+
+	assert.NilError(t, err)
+	assert.Equal(t, 4, len(v))
+	assert.Equal(t, true, math.IsInf(v[0], 1))
+	assert.Equal(t, true, math.IsInf(v[1], -1))
+	assert.Equal(t, true, math.IsNaN(v[2]))
+	assert.Equal(t, 1000.0, v[3])
+}
+
+func TestYamlUnmarshalErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		v       any
+		wantErr error
+		errWith string
+	}{
+		{
+			name:    "invalid-indentation",
+			content: "a: 1\n  b: 2\n",
+			v:       &map[string]int{},
+			wantErr: datas.ErrInvalidYaml,
+			errWith: "line 2",
+		},
+		{
+			name:    "invalid-tab-indentation",
+			content: "a:\n\tb: 2\n",
+			v:       &map[string]any{},
+			wantErr: datas.ErrInvalidYaml,
+			errWith: "line 2",
+		},
+		{
+			name:    "invalid-duplicated-key",
+			content: "a: 1\na: 2\n",
+			v:       &map[string]int{},
+			wantErr: datas.ErrInvalidYaml,
+			errWith: "duplicated key 'a'",
+		},
+		{
+			name:    "invalid-unterminated-quote",
+			content: `a: "text`,
+			v:       &map[string]string{},
+			wantErr: datas.ErrInvalidYaml,
+		},
+		{
+			name:    "invalid-unterminated-flow",
+			content: "a: [1, 2\n",
+			v:       &map[string][]int{},
+			wantErr: datas.ErrInvalidYaml,
+		},
+		{
+			name:    "invalid-unknown-alias",
+			content: "a: *missing\n",
+			v:       &map[string]string{},
+			wantErr: datas.ErrYamlUnknownAlias,
+		},
+		{
+			name:    "invalid-type",
+			content: "port: abc\n",
+			v:       &yamlDatabase{},
+			wantErr: datas.ErrYamlTypeMismatch,
+			errWith: "line 1",
+		},
+		{
+			name:    "invalid-collection-type",
+			content: "host:\n  - a\n",
+			v:       &yamlDatabase{},
+			wantErr: datas.ErrYamlTypeMismatch,
+		},
+		{
+			name:    "invalid-non-pointer",
+			content: "a: 1\n",
+			v:       map[string]int{},
+			wantErr: datas.ErrYamlNonPointer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := datas.Yaml().Unmarshal([]byte(tt.content), tt.v)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.errWith != "" {
+				assert.ErrorWith(t, err, tt.errWith)
+			}
+		})
+	}
+}
+
+func TestYamlMarshal(t *testing.T) {
+	v := yamlConfig{
+		yamlBase: yamlBase{Version: "1.0"},
+		Name:     "service",
+		Enabled:  true,
+		Ratio:    0.5,
+		Tags:     []string{"api", "", "true", "- dash", "multi\nline"},
+		Labels:   map[string]string{"b": "2", "a": "x: y"},
+		Database: yamlDatabase{Host: "db.local", Port: 5432, Timeout: 15 * time.Second},
+		Backups:  []yamlDatabase{{Host: "backup", Replica: &yamlDatabase{Host: "r"}}},
+		Limits:   map[string]yamlDatabase{},
+		IP:       net.ParseIP("10.0.0.1"),
+		Extra:    []any{[]int{1, 2}, nil},
+	}
+
+	b, err := datas.Yaml().Marshal(v)
+	assert.NilError(t, err)
+
+	expected := `name: service
+enabled: true
+ratio: 0.5
+tags:
+  - api
+  - ""
+  - "true"
+  - "- dash"
+  - "multi\nline"
+ports: null
+labels:
+  a: "x: y"
+  b: "2"
+database:
+  host: db.local
+  port: 5432
+  timeout: 15s
+backups:
+  - host: backup
+    port: 0
+    replica:
+      host: r
+      port: 0
+limits: {}
+ip: 10.0.0.1
+extra:
+  - - 1
+    - 2
+  - null
+Untagged: ""
+version: "1.0"
+`
+	assert.Equal(t, expected, string(b))
+
+	var decoded yamlConfig
+	err = datas.Yaml().Unmarshal(b, &decoded)
+	assert.NilError(t, err)
+	assert.Equal(t, v.Tags, decoded.Tags)
+	assert.Equal(t, v.Labels, decoded.Labels)
+	assert.Equal(t, v.Database, decoded.Database)
+	assert.Equal(t, v.Backups, decoded.Backups)
+	assert.Equal(t, v.Version, decoded.Version)
+	assert.Equal(t, []any{[]any{1, 2}, nil}, decoded.Extra)
+}
+
+func TestYamlIO(t *testing.T) {
+	yaml := datas.Yaml()
+	data := jsonStruct{Foo: "failure"}
+
+	err := yaml.UnmarshalFrom(bytes.NewReader([]byte("foo: success\n")), &data)
+	assert.NilError(t, err, "yaml.UnmarshalFrom(..)")
+	assert.Equal(t, "success", data.Foo, "yaml.UnmarshalFrom(..)")
+
+	writerCallCounter := assert.Count(t, 1)
+	w := &mocks.Writer{
+		OnWrite: func(p []byte) (n int, err error) {
+			writerCallCounter.Inc()
+			assert.Equal(t, "foo: success\n", string(p))
+			return len(p), nil
+		},
+	}
+
+	err = yaml.MarshalTo(w, data)
+	assert.NilError(t, err, "yaml.MarshalTo(..)")
+	writerCallCounter.Assert(t, "yaml.MarshalTo(..)")
+
+	_, err = yaml.Marshal(map[string]any{"fn": func() {}})
+	assert.ErrorIs(t, err, datas.ErrYamlUnsupportedType)
+
+	err = yaml.UnmarshalFrom(strings.NewReader("foo: [unclosed"), &data)
+	assert.ErrorIs(t, err, datas.ErrInvalidYaml)
+}
+
+func TestFieldTags(t *testing.T) {
+	assert.Equal(t, []string{"json"}, datas.Json().(datas.FieldTagger).FieldTags())
+	assert.Equal(t, []string{"yaml", "json"}, datas.Yaml().(datas.FieldTagger).FieldTags())
+}
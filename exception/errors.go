@@ -35,6 +35,11 @@ func (err AggregatedError) Error() string {
 	return Aggregatedf(err...).Error()
 }
 
+// Unwrap returns aggregated errors, so errors.Is and errors.As can match any of them.
+func (err AggregatedError) Unwrap() []error {
+	return err
+}
+
 // Delete removes the element at i index from s, returning the modified slice.
 func delete[S ~[]E, E any](s S, i int) S {
 	return append(s[:i], s[i+1:]...)
@@ -2,6 +2,7 @@ package exception_test
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -72,6 +73,14 @@ func TestAggregate(t *testing.T) {
 	}
 }
 
+func TestAggregatedErrorUnwrap(t *testing.T) {
+	target := errors.New("target")
+	err := exception.Aggregate(errors.New("1"), fmt.Errorf("wrapped: %w", target))
+
+	assert.ErrorIs(t, err, target)
+	assert.Equal(t, false, errors.Is(err, errors.New("target")))
+}
+
 func TestAggregatef(t *testing.T) {
 	tests := []struct {
 		name    string
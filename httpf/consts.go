@@ -16,9 +16,11 @@ const (
 	ContentTypeHeader             = "Content-Type"
 	CookieHeader                  = "Cookie"
 	DateHeader                    = "Date"
+	ETagHeader                    = "ETag"
 	ExpiresHeader                 = "Expires"
 	FromHeader                    = "From"
 	HostHeader                    = "Host"
+	IfNoneMatchHeader             = "If-None-Match"
 	LocationHeader                = "Location"
 	ServerHeader                  = "Server"
 	SetCookieHeader               = "Set-Cookie"
@@ -56,17 +56,27 @@ func CastFieldValue(fieldType reflect.Type, rawValue any) (reflect.Value, bool)
 
 // GetFieldValueFor returns a reflect.Value which matches fieldType and value of rawValue.
 // If rawValue type is different than fieldType then it's converted or parsed to match the type.
+// String rawValue is always parsed for types reported by IsTextType, even if it's convertible to them.
 // If value cannot be converted or parsed to expected type, it'll return an ErrNotSupportedType error.
-func GetFieldValueFor(fieldType reflect.Type, rawValue any) (reflect.Value, error) {
+func GetFieldValueFor(fieldType reflect.Type, rawValue any, opts ...ParseOption) (reflect.Value, error) {
+	if s, ok := rawValue.(string); ok && IsTextType(fieldType) {
+		return parseFieldValue(fieldType, s, opts...)
+	}
+
 	val, ok := CastFieldValue(fieldType, rawValue)
 	if ok || val == reflect.Zero(fieldType) {
 		return val, nil
 	}
 
+	return parseFieldValue(fieldType, val.String(), opts...)
+}
+
+// parseFieldValue returns s parsed to fieldType which can be a pointer.
+func parseFieldValue(fieldType reflect.Type, s string, opts ...ParseOption) (reflect.Value, error) {
 	isFieldTypePointer := fieldType.Kind() == reflect.Pointer
 	if isFieldTypePointer {
 		defaultValue := reflect.Zero(fieldType.Elem())
-		vv, err := Parse(val.String(), defaultValue)
+		vv, err := Parse(s, defaultValue, opts...)
 		if err != nil {
 			return reflect.Value{}, err
 		}
@@ -77,7 +87,7 @@ func GetFieldValueFor(fieldType reflect.Type, rawValue any) (reflect.Value, erro
 	}
 
 	defaultValue := reflect.Zero(fieldType)
-	vv, err := Parse(val.String(), defaultValue)
+	vv, err := Parse(s, defaultValue, opts...)
 	if err != nil {
 		return reflect.Value{}, err
 	}
@@ -86,7 +96,7 @@ func GetFieldValueFor(fieldType reflect.Type, rawValue any) (reflect.Value, erro
 
 // SetFieldValue calls GetFieldValueFor and sets got value directly to field or error if occurred.
 // If rawValue is nil - it will not set nil value to field - use reflect.ValueOf(nil) in this case.
-func SetFieldValue(field reflect.Value, rawValue any) error {
+func SetFieldValue(field reflect.Value, rawValue any, opts ...ParseOption) error {
 	if !field.CanSet() {
 		return ErrNotSupportedType
 	}
@@ -96,7 +106,7 @@ func SetFieldValue(field reflect.Value, rawValue any) error {
 		return nil
 	}
 
-	v, err := GetFieldValueFor(field.Type(), rawValue)
+	v, err := GetFieldValueFor(field.Type(), rawValue, opts...)
 	if err != nil {
 		return err
 	}
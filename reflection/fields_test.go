@@ -1,6 +1,8 @@
 package reflection_test
 
 import (
+	"net"
+	"net/url"
 	"reflect"
 	"testing"
 
@@ -209,6 +211,20 @@ func TestGetFieldValueFor(t *testing.T) {
 			want:      reflect.ValueOf(0),
 			wantErr:   false,
 		},
+		{
+			name:      "success-text-type-not-converted",
+			fieldType: reflect.TypeOf(net.IP{}),
+			rawValue:  "10.0.0.1",
+			want:      reflect.ValueOf(net.ParseIP("10.0.0.1")),
+			wantErr:   false,
+		},
+		{
+			name:      "success-pointer-url",
+			fieldType: reflect.TypeOf(&url.URL{}),
+			rawValue:  "https://example.com",
+			want:      reflect.ValueOf(&url.URL{Scheme: "https", Host: "example.com"}),
+			wantErr:   false,
+		},
 		{
 			name:      "invalid-pointer-not-parsable",
 			fieldType: reflect.TypeOf(ptr(struct{}{})),
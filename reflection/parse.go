@@ -1,21 +1,73 @@
 package reflection
 
 import (
+	"encoding"
 	"errors"
+	"net/url"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 )
 
 var (
 	ErrNotSupportedType = errors.New("target type is not supported by parser")
+	ErrInvalidMapEntry  = errors.New("map entry must contain key and value separated with key-value separator")
 )
 
+const (
+	DefaultListSeparator     = ","
+	DefaultKeyValueSeparator = "="
+)
+
+var textUnmarshalerType = TypeOf[encoding.TextUnmarshaler]()
+
+type ParseOption func(*ParseOptions)
+
+// ParseOptions controls syntax of composite values like slices and maps.
+type ParseOptions struct {
+	ListSeparator     string
+	KeyValueSeparator string
+}
+
+// NewParseOptions returns ParseOptions with DefaultListSeparator and DefaultKeyValueSeparator
+// overridden by specified options.
+func NewParseOptions(options ...ParseOption) *ParseOptions {
+	opts := &ParseOptions{
+		ListSeparator:     DefaultListSeparator,
+		KeyValueSeparator: DefaultKeyValueSeparator,
+	}
+	for _, o := range options {
+		o(opts)
+	}
+
+	return opts
+}
+
+// WithListSeparator sets separator used to split slice elements and map entries.
+func WithListSeparator(sep string) ParseOption {
+	return func(o *ParseOptions) {
+		o.ListSeparator = sep
+	}
+}
+
+// WithKeyValueSeparator sets separator used to split map entry to key and value.
+func WithKeyValueSeparator(sep string) ParseOption {
+	return func(o *ParseOptions) {
+		o.KeyValueSeparator = sep
+	}
+}
+
 // Parse returns the parsed value with target type. s can be parsed to any of these types:
-// string, int, float, complex, bool, time.Duration, time.Time, error. It accepts both
+// string, int, float, complex, bool, time.Duration, time.Time, error, url.URL and any type
+// implementing encoding.TextUnmarshaler with pointer receiver (e.g. net.IP). It accepts both
 // pointer or non-pointer type. If s value is convertible to target it will return the converted value.
+// Slices are parsed from elements separated with ParseOptions.ListSeparator (e.g. "a,b,c") and maps from
+// entries separated with ParseOptions.ListSeparator which key and value are separated with
+// ParseOptions.KeyValueSeparator (e.g. "a=1,b=2"). Each element, key and value is parsed with the same rules.
+// Empty string is parsed to empty slice or map.
 // Returns ErrNotSupportedType error if cannot parse to target value.
-func Parse(s string, target interface{}) (interface{}, error) {
+func Parse(s string, target interface{}, opts ...ParseOption) (interface{}, error) {
 	if val, ok := target.(reflect.Value); ok {
 		if val.Kind() == reflect.Pointer {
 			val = val.Elem()
@@ -97,13 +149,127 @@ func Parse(s string, target interface{}) (interface{}, error) {
 
 	case error:
 		return errors.New(s), nil
+
+	case url.URL:
+		u, err := url.Parse(s)
+		if err != nil {
+			return nil, err
+		}
+		return *u, nil
 	}
 
-	sVal := reflect.ValueOf(s)
 	targetTyp := reflect.TypeOf(target)
+	if targetTyp == nil {
+		return nil, ErrNotSupportedType
+	}
+
+	if reflect.PointerTo(targetTyp).Implements(textUnmarshalerType) {
+		return parseText(s, targetTyp)
+	}
+
+	sVal := reflect.ValueOf(s)
 	if reflect.TypeOf(s).ConvertibleTo(targetTyp) {
 		return sVal.Convert(targetTyp).Interface(), nil
 	}
 
+	switch targetTyp.Kind() {
+	case reflect.Slice:
+		return parseSlice(s, targetTyp, NewParseOptions(opts...))
+	case reflect.Map:
+		return parseMap(s, targetTyp, NewParseOptions(opts...))
+	}
+
 	return nil, ErrNotSupportedType
 }
+
+// IsTextType reports whether values of t type (or type pointed by t) are parsed from text by
+// encoding.TextUnmarshaler or are url.URL. Such types should be parsed even if string is convertible to them.
+func IsTextType(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t == reflect.TypeOf(url.URL{}) || reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+func parseText(s string, t reflect.Type) (interface{}, error) {
+	p := reflect.New(t)
+	if err := p.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s)); err != nil {
+		return nil, err
+	}
+	return p.Elem().Interface(), nil
+}
+
+func parseSlice(s string, t reflect.Type, opts *ParseOptions) (interface{}, error) {
+	if s == "" {
+		return reflect.MakeSlice(t, 0, 0).Interface(), nil
+	}
+
+	elems := strings.Split(s, opts.ListSeparator)
+	slice := reflect.MakeSlice(t, len(elems), len(elems))
+	for i, elem := range elems {
+		v, err := parseElem(elem, t.Elem(), opts)
+		if err != nil {
+			return nil, err
+		}
+		slice.Index(i).Set(v)
+	}
+
+	return slice.Interface(), nil
+}
+
+func parseMap(s string, t reflect.Type, opts *ParseOptions) (interface{}, error) {
+	m := reflect.MakeMap(t)
+	if s == "" {
+		return m.Interface(), nil
+	}
+
+	for _, entry := range strings.Split(s, opts.ListSeparator) {
+		key, value, ok := strings.Cut(entry, opts.KeyValueSeparator)
+		if !ok {
+			return nil, ErrInvalidMapEntry
+		}
+
+		k, err := parseElem(key, t.Key(), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := parseElem(value, t.Elem(), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		m.SetMapIndex(k, v)
+	}
+
+	return m.Interface(), nil
+}
+
+// parseElem parses s to value of t type which can be a pointer.
+func parseElem(s string, t reflect.Type, opts *ParseOptions) (reflect.Value, error) {
+	elemType := t
+	if t.Kind() == reflect.Pointer {
+		elemType = t.Elem()
+	}
+
+	v, err := Parse(s, elemType, withOptions(opts))
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	val := reflect.ValueOf(v)
+	if t.Kind() == reflect.Pointer {
+		p := reflect.New(elemType)
+		p.Elem().Set(val)
+		return p, nil
+	}
+	return val, nil
+}
+
+// withOptions returns ParseOption copying all opts settings.
+func withOptions(opts *ParseOptions) ParseOption {
+	return func(o *ParseOptions) {
+		*o = *opts
+	}
+}
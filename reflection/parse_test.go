@@ -2,6 +2,8 @@ package reflection_test
 
 import (
 	"errors"
+	"net"
+	"net/url"
 	"reflect"
 	"testing"
 	"time"
@@ -177,6 +179,83 @@ func TestParse(t *testing.T) {
 			want:    convertibleString("1"),
 			wantErr: false,
 		},
+		{
+			name:    "success-slice",
+			str:     "1,2,3",
+			target:  []int{},
+			want:    []int{1, 2, 3},
+			wantErr: false,
+		},
+		{
+			name:    "success-empty-slice",
+			str:     "",
+			target:  []string(nil),
+			want:    []string{},
+			wantErr: false,
+		},
+		{
+			name:    "success-pointer-elem-slice",
+			str:     "true",
+			target:  []*bool{},
+			want:    []*bool{&b},
+			wantErr: false,
+		},
+		{
+			name:    "success-bytes",
+			str:     "a,b",
+			target:  []byte{},
+			want:    []byte("a,b"),
+			wantErr: false,
+		},
+		{
+			name:    "success-map",
+			str:     "a=1,b=2",
+			target:  map[string]int{},
+			want:    map[string]int{"a": 1, "b": 2},
+			wantErr: false,
+		},
+		{
+			name:    "success-text-unmarshaler",
+			str:     "10.0.0.1",
+			target:  net.IP{},
+			want:    net.ParseIP("10.0.0.1"),
+			wantErr: false,
+		},
+		{
+			name:    "success-url",
+			str:     "https://example.com/path",
+			target:  url.URL{},
+			want:    url.URL{Scheme: "https", Host: "example.com", Path: "/path"},
+			wantErr: false,
+		},
+		{
+			name:    "invalid-slice-elem",
+			str:     "1,a",
+			target:  []int{},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "invalid-map-entry",
+			str:     "a=1,b",
+			target:  map[string]int{},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "invalid-text-unmarshaler",
+			str:     "256.0.0.1",
+			target:  net.IP{},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "invalid-url",
+			str:     "://",
+			target:  url.URL{},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name:    "invalid-type",
 			str:     "{}",
@@ -210,3 +289,16 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestParseWithOptions(t *testing.T) {
+	got, err := reflection.Parse("a:1;b:2", map[string]int{},
+		reflection.WithListSeparator(";"),
+		reflection.WithKeyValueSeparator(":"),
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, got)
+
+	got, err = reflection.Parse("a=1", map[string]int{}, reflection.WithKeyValueSeparator(":"))
+	assert.ErrorIs(t, err, reflection.ErrInvalidMapEntry)
+	assert.Equal(t, nil, got)
+}
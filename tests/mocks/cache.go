@@ -9,8 +9,11 @@ import (
 )
 
 var (
-	_ caches.Cache            = CacheMock{}
-	_ caches.DistributedCache = DistributedCacheMock{}
+	_ caches.Cache                = CacheMock{}
+	_ caches.DistributedCache     = DistributedCacheMock{}
+	_ caches.ByteStore            = ByteStoreMock{}
+	_ caches.DistributedByteStore = DistributedByteStoreMock{}
+	_ caches.TaggedCache          = TaggedCacheMock{}
 )
 
 type CacheMock struct {
@@ -54,3 +57,84 @@ func (m DistributedCacheMock) Set(ctx context.Context, key string, value interfa
 	assert.ExpectCall(m.OnSet)
 	return m.OnSet(ctx, key, value, ttl)
 }
+
+type ByteStoreMock struct {
+	OnDelete func(key string) error
+	OnGet    func(key string) ([]byte, error)
+	OnSet    func(key string, value []byte, ttl time.Duration) error
+}
+
+func (m ByteStoreMock) Delete(key string) error {
+	assert.ExpectCall(m.OnDelete)
+	return m.OnDelete(key)
+}
+
+func (m ByteStoreMock) Get(key string) ([]byte, error) {
+	assert.ExpectCall(m.OnGet)
+	return m.OnGet(key)
+}
+
+func (m ByteStoreMock) Set(key string, value []byte, ttl time.Duration) error {
+	assert.ExpectCall(m.OnSet)
+	return m.OnSet(key, value, ttl)
+}
+
+type DistributedByteStoreMock struct {
+	OnDelete func(ctx context.Context, key string) error
+	OnGet    func(ctx context.Context, key string) ([]byte, error)
+	OnSet    func(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+func (m DistributedByteStoreMock) Delete(ctx context.Context, key string) error {
+	assert.ExpectCall(m.OnDelete)
+	return m.OnDelete(ctx, key)
+}
+
+func (m DistributedByteStoreMock) Get(ctx context.Context, key string) ([]byte, error) {
+	assert.ExpectCall(m.OnGet)
+	return m.OnGet(ctx, key)
+}
+
+func (m DistributedByteStoreMock) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	assert.ExpectCall(m.OnSet)
+	return m.OnSet(ctx, key, value, ttl)
+}
+
+type TaggedCacheMock struct {
+	OnDelete        func(key string) error
+	OnGet           func(key string, v interface{}) error
+	OnSet           func(key string, value interface{}, ttl time.Duration) error
+	OnSetWithTags   func(key string, value interface{}, ttl time.Duration, tags ...string) error
+	OnInvalidateTag func(tag string) error
+	OnDeletePrefix  func(prefix string) error
+}
+
+func (m TaggedCacheMock) Delete(key string) error {
+	assert.ExpectCall(m.OnDelete)
+	return m.OnDelete(key)
+}
+
+func (m TaggedCacheMock) Get(key string, v interface{}) error {
+	assert.ExpectCall(m.OnGet)
+	return m.OnGet(key, v)
+}
+
+func (m TaggedCacheMock) Set(key string, value interface{}, ttl time.Duration) error {
+	assert.ExpectCall(m.OnSet)
+	return m.OnSet(key, value, ttl)
+}
+
+func (m TaggedCacheMock) SetWithTags(key string, value interface{}, ttl time.Duration, tags ...string) error {
+	assert.ExpectCall(m.OnSetWithTags)
+	return m.OnSetWithTags(key, value, ttl, tags...)
+}
+
+func (m TaggedCacheMock) InvalidateTag(tag string) error {
+	assert.ExpectCall(m.OnInvalidateTag)
+	return m.OnInvalidateTag(tag)
+}
+
+func (m TaggedCacheMock) DeletePrefix(prefix string) error {
+	assert.ExpectCall(m.OnDeletePrefix)
+	return m.OnDeletePrefix(prefix)
+}